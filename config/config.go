@@ -30,6 +30,32 @@ const (
 	EnvProduction environment = "prod"
 )
 
+type cacheStore string
+
+const (
+	// CacheStoreMemory represents the in-memory cache store.
+	CacheStoreMemory cacheStore = "memory"
+
+	// CacheStoreRedis represents the Redis cache store.
+	CacheStoreRedis cacheStore = "redis"
+
+	// CacheStoreDatabase represents the database cache store.
+	CacheStoreDatabase cacheStore = "database"
+)
+
+type cacheCodec string
+
+const (
+	// CacheCodecGob represents the gob cache codec.
+	CacheCodecGob cacheCodec = "gob"
+
+	// CacheCodecJSON represents the JSON cache codec.
+	CacheCodecJSON cacheCodec = "json"
+
+	// CacheCodecMsgpack represents the MessagePack cache codec.
+	CacheCodecMsgpack cacheCodec = "msgpack"
+)
+
 // SwitchEnvironment sets the environment variable used to dictate which environment the application is
 // currently running in.
 // This must be called prior to loading the configuration in order for it to take effect.
@@ -42,13 +68,15 @@ func SwitchEnvironment(env environment) {
 type (
 	// Config stores complete configuration.
 	Config struct {
-		HTTP     HTTPConfig
-		App      AppConfig
-		Cache    CacheConfig
-		Database DatabaseConfig
-		Files    FilesConfig
-		Tasks    TasksConfig
-		Mail     MailConfig
+		HTTP      HTTPConfig
+		App       AppConfig
+		Cache     CacheConfig
+		Database  DatabaseConfig
+		Files     FilesConfig
+		Tasks     TasksConfig
+		Mail      MailConfig
+		RateLimit RateLimitConfig
+		OAuth     OAuthConfig
 	}
 
 	// HTTPConfig stores HTTP configuration.
@@ -78,23 +106,82 @@ type (
 			Length     int
 		}
 		EmailVerificationTokenExpiration time.Duration
+		UnverifiedLoginGracePeriod       time.Duration
+		MagicLinkExpiration              time.Duration
+		PasswordPolicy                   struct {
+			MinLength     int
+			RequireUpper  bool
+			RequireLower  bool
+			RequireDigit  bool
+			RequireSymbol bool
+			BreachedList  string
+		}
+		Lockout struct {
+			Window   time.Duration
+			Attempts int
+			Duration time.Duration
+			Delay    struct {
+				After   int
+				IPAfter int
+				Base    time.Duration
+				Max     time.Duration
+			}
+		}
+		TOTP struct {
+			PendingExpiration time.Duration
+			RecoveryCodes     int
+		}
+		Session struct {
+			Expiration    time.Duration
+			TouchInterval time.Duration
+		}
+		RememberMe struct {
+			Expiration  time.Duration
+			GracePeriod time.Duration
+		}
+		Registration struct {
+			InviteOnly           bool
+			InvitationExpiration time.Duration
+		}
+		Passkeys struct {
+			RPID    string
+			Origins []string
+			Timeout time.Duration
+		}
 	}
 
 	// CacheConfig stores the cache configuration.
 	CacheConfig struct {
-		Capacity   int
+		Store    cacheStore
+		Codec    cacheCodec
+		Capacity int
+		Redis    struct {
+			Address      string
+			Password     string
+			Database     int
+			TestDatabase int
+		}
+		Database struct {
+			SweepInterval time.Duration
+		}
+		L1 struct {
+			Enabled    bool
+			Capacity   int
+			Expiration time.Duration
+		}
 		Expiration struct {
 			PublicFile time.Duration
+			Page       time.Duration
 		}
 	}
 
 	// DatabaseConfig stores the database configuration.
 	DatabaseConfig struct {
-		Driver           string
-		Connection       string // For SQLite
-		TestConnection   string // For SQLite
-		PostgresDSN      string // For PostgreSQL
-		PostgresTestDSN  string // For PostgreSQL test
+		Driver          string
+		Connection      string // For SQLite
+		TestConnection  string // For SQLite
+		PostgresDSN     string // For PostgreSQL
+		PostgresTestDSN string // For PostgreSQL test
 	}
 
 	// FilesConfig stores the file system configuration.
@@ -118,6 +205,40 @@ type (
 		Password    string
 		FromAddress string
 	}
+
+	// RateLimitConfig stores the rate limits of actions which are prone to abuse.
+	RateLimitConfig struct {
+		Login          RateLimitRule
+		Register       RateLimitRule
+		ForgotPassword RateLimitRule
+		MagicLink      RateLimitRule
+		EmailChange    RateLimitRule
+		VerifyEmail    RateLimitRule
+		Contact        RateLimitRule
+	}
+
+	// OAuthConfig stores the configuration of the external identity providers users can log in with.
+	OAuthConfig struct {
+		Providers map[string]OAuthProviderConfig
+	}
+
+	// OAuthProviderConfig stores the configuration of an OAuth2 or OpenID Connect identity provider.
+	OAuthProviderConfig struct {
+		Label        string
+		ClientID     string
+		ClientSecret string
+		Issuer       string
+		AuthURL      string
+		TokenURL     string
+		UserInfoURL  string
+		Scopes       []string
+	}
+
+	// RateLimitRule stores how many times an action can be performed within a given window.
+	RateLimitRule struct {
+		Limit  int
+		Window time.Duration
+	}
 )
 
 // GetConfig loads and returns configuration.
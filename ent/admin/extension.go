@@ -36,21 +36,9 @@ func (*Extension) Templates() []*gen.Template {
 }
 
 // fieldName provides a struct field name from an entity field name (ie, user_id -> UserID).
+// This uses the same naming rules as Ent, including acronyms (ie, ip -> IP), so that it matches the generated code.
 func fieldName(name string) string {
-	if len(name) == 0 {
-		return name
-	}
-
-	parts := strings.Split(name, "_")
-	for i := 0; i < len(parts); i++ {
-		if parts[i] == "id" {
-			parts[i] = "ID"
-		} else {
-			parts[i] = upperFirst(parts[i])
-		}
-	}
-
-	return strings.Join(parts, "")
+	return gen.Funcs["pascal"].(func(string) string)(name)
 }
 
 // FieldLabel provides a label for an entity field name (ie, user_id -> User ID).
@@ -11,8 +11,22 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/apitoken"
+	"github.com/mikestefanello/pagoda/ent/impersonation"
+	"github.com/mikestefanello/pagoda/ent/invitation"
+	"github.com/mikestefanello/pagoda/ent/loginattempt"
+	"github.com/mikestefanello/pagoda/ent/magiclink"
 	"github.com/mikestefanello/pagoda/ent/passwordtoken"
+	"github.com/mikestefanello/pagoda/ent/permission"
+	"github.com/mikestefanello/pagoda/ent/recoverycode"
+	"github.com/mikestefanello/pagoda/ent/remembertoken"
+	"github.com/mikestefanello/pagoda/ent/role"
+	"github.com/mikestefanello/pagoda/ent/rolepermission"
+	"github.com/mikestefanello/pagoda/ent/session"
 	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/ent/useridentity"
+	"github.com/mikestefanello/pagoda/ent/userrole"
+	"github.com/mikestefanello/pagoda/ent/webauthncredential"
 )
 
 const dateTimeFormat = "2006-01-02T15:04:05"
@@ -32,10 +46,38 @@ func NewHandler(client *ent.Client, cfg HandlerConfig) *Handler {
 
 func (h *Handler) Create(ctx echo.Context, entityType string) error {
 	switch entityType {
+	case "APIToken":
+		return h.APITokenCreate(ctx)
+	case "Impersonation":
+		return h.ImpersonationCreate(ctx)
+	case "Invitation":
+		return h.InvitationCreate(ctx)
+	case "LoginAttempt":
+		return h.LoginAttemptCreate(ctx)
+	case "MagicLink":
+		return h.MagicLinkCreate(ctx)
 	case "PasswordToken":
 		return h.PasswordTokenCreate(ctx)
+	case "Permission":
+		return h.PermissionCreate(ctx)
+	case "RecoveryCode":
+		return h.RecoveryCodeCreate(ctx)
+	case "RememberToken":
+		return h.RememberTokenCreate(ctx)
+	case "Role":
+		return h.RoleCreate(ctx)
+	case "RolePermission":
+		return h.RolePermissionCreate(ctx)
+	case "Session":
+		return h.SessionCreate(ctx)
 	case "User":
 		return h.UserCreate(ctx)
+	case "UserIdentity":
+		return h.UserIdentityCreate(ctx)
+	case "UserRole":
+		return h.UserRoleCreate(ctx)
+	case "WebAuthnCredential":
+		return h.WebAuthnCredentialCreate(ctx)
 	default:
 		return fmt.Errorf("unsupported entity type: %s", entityType)
 	}
@@ -43,10 +85,38 @@ func (h *Handler) Create(ctx echo.Context, entityType string) error {
 
 func (h *Handler) Get(ctx echo.Context, entityType string, id int) (url.Values, error) {
 	switch entityType {
+	case "APIToken":
+		return h.APITokenGet(ctx, id)
+	case "Impersonation":
+		return h.ImpersonationGet(ctx, id)
+	case "Invitation":
+		return h.InvitationGet(ctx, id)
+	case "LoginAttempt":
+		return h.LoginAttemptGet(ctx, id)
+	case "MagicLink":
+		return h.MagicLinkGet(ctx, id)
 	case "PasswordToken":
 		return h.PasswordTokenGet(ctx, id)
+	case "Permission":
+		return h.PermissionGet(ctx, id)
+	case "RecoveryCode":
+		return h.RecoveryCodeGet(ctx, id)
+	case "RememberToken":
+		return h.RememberTokenGet(ctx, id)
+	case "Role":
+		return h.RoleGet(ctx, id)
+	case "RolePermission":
+		return h.RolePermissionGet(ctx, id)
+	case "Session":
+		return h.SessionGet(ctx, id)
 	case "User":
 		return h.UserGet(ctx, id)
+	case "UserIdentity":
+		return h.UserIdentityGet(ctx, id)
+	case "UserRole":
+		return h.UserRoleGet(ctx, id)
+	case "WebAuthnCredential":
+		return h.WebAuthnCredentialGet(ctx, id)
 	default:
 		return nil, fmt.Errorf("unsupported entity type: %s", entityType)
 	}
@@ -54,10 +124,38 @@ func (h *Handler) Get(ctx echo.Context, entityType string, id int) (url.Values,
 
 func (h *Handler) Delete(ctx echo.Context, entityType string, id int) error {
 	switch entityType {
+	case "APIToken":
+		return h.APITokenDelete(ctx, id)
+	case "Impersonation":
+		return h.ImpersonationDelete(ctx, id)
+	case "Invitation":
+		return h.InvitationDelete(ctx, id)
+	case "LoginAttempt":
+		return h.LoginAttemptDelete(ctx, id)
+	case "MagicLink":
+		return h.MagicLinkDelete(ctx, id)
 	case "PasswordToken":
 		return h.PasswordTokenDelete(ctx, id)
+	case "Permission":
+		return h.PermissionDelete(ctx, id)
+	case "RecoveryCode":
+		return h.RecoveryCodeDelete(ctx, id)
+	case "RememberToken":
+		return h.RememberTokenDelete(ctx, id)
+	case "Role":
+		return h.RoleDelete(ctx, id)
+	case "RolePermission":
+		return h.RolePermissionDelete(ctx, id)
+	case "Session":
+		return h.SessionDelete(ctx, id)
 	case "User":
 		return h.UserDelete(ctx, id)
+	case "UserIdentity":
+		return h.UserIdentityDelete(ctx, id)
+	case "UserRole":
+		return h.UserRoleDelete(ctx, id)
+	case "WebAuthnCredential":
+		return h.WebAuthnCredentialDelete(ctx, id)
 	default:
 		return fmt.Errorf("unsupported entity type: %s", entityType)
 	}
@@ -65,10 +163,38 @@ func (h *Handler) Delete(ctx echo.Context, entityType string, id int) error {
 
 func (h *Handler) Update(ctx echo.Context, entityType string, id int) error {
 	switch entityType {
+	case "APIToken":
+		return h.APITokenUpdate(ctx, id)
+	case "Impersonation":
+		return h.ImpersonationUpdate(ctx, id)
+	case "Invitation":
+		return h.InvitationUpdate(ctx, id)
+	case "LoginAttempt":
+		return h.LoginAttemptUpdate(ctx, id)
+	case "MagicLink":
+		return h.MagicLinkUpdate(ctx, id)
 	case "PasswordToken":
 		return h.PasswordTokenUpdate(ctx, id)
+	case "Permission":
+		return h.PermissionUpdate(ctx, id)
+	case "RecoveryCode":
+		return h.RecoveryCodeUpdate(ctx, id)
+	case "RememberToken":
+		return h.RememberTokenUpdate(ctx, id)
+	case "Role":
+		return h.RoleUpdate(ctx, id)
+	case "RolePermission":
+		return h.RolePermissionUpdate(ctx, id)
+	case "Session":
+		return h.SessionUpdate(ctx, id)
 	case "User":
 		return h.UserUpdate(ctx, id)
+	case "UserIdentity":
+		return h.UserIdentityUpdate(ctx, id)
+	case "UserRole":
+		return h.UserRoleUpdate(ctx, id)
+	case "WebAuthnCredential":
+		return h.WebAuthnCredentialUpdate(ctx, id)
 	default:
 		return fmt.Errorf("unsupported entity type: %s", entityType)
 	}
@@ -76,40 +202,1196 @@ func (h *Handler) Update(ctx echo.Context, entityType string, id int) error {
 
 func (h *Handler) List(ctx echo.Context, entityType string) (*EntityList, error) {
 	switch entityType {
+	case "APIToken":
+		return h.APITokenList(ctx)
+	case "Impersonation":
+		return h.ImpersonationList(ctx)
+	case "Invitation":
+		return h.InvitationList(ctx)
+	case "LoginAttempt":
+		return h.LoginAttemptList(ctx)
+	case "MagicLink":
+		return h.MagicLinkList(ctx)
 	case "PasswordToken":
 		return h.PasswordTokenList(ctx)
+	case "Permission":
+		return h.PermissionList(ctx)
+	case "RecoveryCode":
+		return h.RecoveryCodeList(ctx)
+	case "RememberToken":
+		return h.RememberTokenList(ctx)
+	case "Role":
+		return h.RoleList(ctx)
+	case "RolePermission":
+		return h.RolePermissionList(ctx)
+	case "Session":
+		return h.SessionList(ctx)
 	case "User":
 		return h.UserList(ctx)
+	case "UserIdentity":
+		return h.UserIdentityList(ctx)
+	case "UserRole":
+		return h.UserRoleList(ctx)
+	case "WebAuthnCredential":
+		return h.WebAuthnCredentialList(ctx)
 	default:
 		return nil, fmt.Errorf("unsupported entity type: %s", entityType)
 	}
 }
 
+func (h *Handler) APITokenCreate(ctx echo.Context) error {
+	var payload APIToken
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.APIToken.Create()
+	op.SetName(payload.Name)
+	if payload.Token != nil {
+		op.SetToken(*payload.Token)
+	}
+	if payload.Scopes != nil {
+		op.SetScopes(*payload.Scopes)
+	}
+	op.SetUserID(payload.UserID)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.ExpiresAt != nil {
+		op.SetExpiresAt(*payload.ExpiresAt)
+	}
+	if payload.LastUsedAt != nil {
+		op.SetLastUsedAt(*payload.LastUsedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) APITokenUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.APIToken.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload APIToken
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetName(payload.Name)
+	if payload.Scopes == nil {
+		op.ClearScopes()
+	} else {
+		op.SetScopes(*payload.Scopes)
+	}
+	if payload.ExpiresAt == nil {
+		op.ClearExpiresAt()
+	} else {
+		op.SetExpiresAt(*payload.ExpiresAt)
+	}
+	if payload.LastUsedAt == nil {
+		op.ClearLastUsedAt()
+	} else {
+		op.SetLastUsedAt(*payload.LastUsedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) APITokenDelete(ctx echo.Context, id int) error {
+	return h.client.APIToken.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) APITokenList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.APIToken.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(apitoken.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Name",
+			"Scopes",
+			"User ID",
+			"Created at",
+			"Expires at",
+			"Last used at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Name,
+				fmt.Sprint(res[i].Scopes),
+				fmt.Sprint(res[i].UserID),
+				formatTime(res[i].CreatedAt, h.Config.TimeFormat),
+				formatTime(res[i].ExpiresAt, h.Config.TimeFormat),
+				formatTime(res[i].LastUsedAt, h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) APITokenGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.APIToken.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("name", entity.Name)
+	v.Set("scopes", fmt.Sprint(entity.Scopes))
+	v.Set("expires_at", formatTime(entity.ExpiresAt, dateTimeFormat))
+	v.Set("last_used_at", formatTime(entity.LastUsedAt, dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) ImpersonationCreate(ctx echo.Context) error {
+	var payload Impersonation
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.Impersonation.Create()
+	op.SetAction(payload.Action)
+	if payload.AdminID != nil {
+		op.SetAdminID(*payload.AdminID)
+	}
+	if payload.UserID != nil {
+		op.SetUserID(*payload.UserID)
+	}
+	op.SetAdminEmail(payload.AdminEmail)
+	op.SetUserEmail(payload.UserEmail)
+	if payload.IP != nil {
+		op.SetIP(*payload.IP)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) ImpersonationUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Impersonation.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload Impersonation
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) ImpersonationDelete(ctx echo.Context, id int) error {
+	return h.client.Impersonation.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) ImpersonationList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.Impersonation.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(impersonation.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Action",
+			"Admin ID",
+			"User ID",
+			"Admin email",
+			"User email",
+			"Ip",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				fmt.Sprint(res[i].Action),
+				fmt.Sprint(res[i].AdminID),
+				fmt.Sprint(res[i].UserID),
+				res[i].AdminEmail,
+				res[i].UserEmail,
+				res[i].IP,
+				formatTime(res[i].CreatedAt, h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) ImpersonationGet(ctx echo.Context, id int) (url.Values, error) {
+	_, err := h.client.Impersonation.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	return v, err
+}
+
+func (h *Handler) InvitationCreate(ctx echo.Context) error {
+	var payload Invitation
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.Invitation.Create()
+	op.SetEmail(payload.Email)
+	if payload.Token != nil {
+		op.SetToken(*payload.Token)
+	}
+	if payload.InviterID != nil {
+		op.SetInviterID(*payload.InviterID)
+	}
+	if payload.UserID != nil {
+		op.SetUserID(*payload.UserID)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	op.SetExpiresAt(payload.ExpiresAt)
+	if payload.AcceptedAt != nil {
+		op.SetAcceptedAt(*payload.AcceptedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) InvitationUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Invitation.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload Invitation
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetEmail(payload.Email)
+	if payload.InviterID == nil {
+		op.ClearInviterID()
+	} else {
+		op.SetInviterID(*payload.InviterID)
+	}
+	if payload.UserID == nil {
+		op.ClearUserID()
+	} else {
+		op.SetUserID(*payload.UserID)
+	}
+	op.SetExpiresAt(payload.ExpiresAt)
+	if payload.AcceptedAt == nil {
+		op.ClearAcceptedAt()
+	} else {
+		op.SetAcceptedAt(*payload.AcceptedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) InvitationDelete(ctx echo.Context, id int) error {
+	return h.client.Invitation.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) InvitationList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.Invitation.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(invitation.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Email",
+			"Inviter ID",
+			"User ID",
+			"Created at",
+			"Expires at",
+			"Accepted at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Email,
+				fmt.Sprint(res[i].InviterID),
+				fmt.Sprint(res[i].UserID),
+				formatTime(res[i].CreatedAt, h.Config.TimeFormat),
+				formatTime(res[i].ExpiresAt, h.Config.TimeFormat),
+				formatTime(res[i].AcceptedAt, h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) InvitationGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Invitation.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("email", entity.Email)
+	v.Set("inviter_id", fmt.Sprint(entity.InviterID))
+	v.Set("user_id", fmt.Sprint(entity.UserID))
+	v.Set("expires_at", formatTime(entity.ExpiresAt, dateTimeFormat))
+	v.Set("accepted_at", formatTime(entity.AcceptedAt, dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) LoginAttemptCreate(ctx echo.Context) error {
+	var payload LoginAttempt
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.LoginAttempt.Create()
+	op.SetEmail(payload.Email)
+	op.SetIP(payload.IP)
+	op.SetSuccess(payload.Success)
+	if payload.UserID != nil {
+		op.SetUserID(*payload.UserID)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) LoginAttemptUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.LoginAttempt.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload LoginAttempt
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetEmail(payload.Email)
+	op.SetIP(payload.IP)
+	op.SetSuccess(payload.Success)
+	if payload.UserID == nil {
+		op.ClearUserID()
+	} else {
+		op.SetUserID(*payload.UserID)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) LoginAttemptDelete(ctx echo.Context, id int) error {
+	return h.client.LoginAttempt.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) LoginAttemptList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.LoginAttempt.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(loginattempt.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Email",
+			"Ip",
+			"Success",
+			"User ID",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Email,
+				res[i].IP,
+				fmt.Sprint(res[i].Success),
+				fmt.Sprint(res[i].UserID),
+				formatTime(res[i].CreatedAt, h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) LoginAttemptGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.LoginAttempt.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("email", entity.Email)
+	v.Set("ip", entity.IP)
+	v.Set("success", fmt.Sprint(entity.Success))
+	v.Set("user_id", fmt.Sprint(entity.UserID))
+	return v, err
+}
+
+func (h *Handler) MagicLinkCreate(ctx echo.Context) error {
+	var payload MagicLink
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.MagicLink.Create()
+	if payload.Jti != nil {
+		op.SetJti(*payload.Jti)
+	}
+	op.SetUserID(payload.UserID)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) MagicLinkUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.MagicLink.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload MagicLink
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) MagicLinkDelete(ctx echo.Context, id int) error {
+	return h.client.MagicLink.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) MagicLinkList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.MagicLink.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(magiclink.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"User ID",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				fmt.Sprint(res[i].UserID),
+				formatTime(res[i].CreatedAt, h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) MagicLinkGet(ctx echo.Context, id int) (url.Values, error) {
+	_, err := h.client.MagicLink.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	return v, err
+}
+
 func (h *Handler) PasswordTokenCreate(ctx echo.Context) error {
 	var payload PasswordToken
 	if err := h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
-	op := h.client.PasswordToken.Create()
+	op := h.client.PasswordToken.Create()
+	if payload.Token != nil {
+		op.SetToken(*payload.Token)
+	}
+	op.SetUserID(payload.UserID)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PasswordTokenUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.PasswordToken.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload PasswordToken
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	if payload.Token != nil {
+		op.SetToken(*payload.Token)
+	}
+	if payload.CreatedAt == nil {
+		var empty time.Time
+		op.SetCreatedAt(empty)
+	} else {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PasswordTokenDelete(ctx echo.Context, id int) error {
+	return h.client.PasswordToken.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) PasswordTokenList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.PasswordToken.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(passwordtoken.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"User ID",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				fmt.Sprint(res[i].UserID),
+				formatTime(res[i].CreatedAt, h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) PasswordTokenGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.PasswordToken.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("created_at", formatTime(entity.CreatedAt, dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) PermissionCreate(ctx echo.Context) error {
+	var payload Permission
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.Permission.Create()
+	op.SetName(payload.Name)
+	if payload.Description != nil {
+		op.SetDescription(*payload.Description)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PermissionUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Permission.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload Permission
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetName(payload.Name)
+	if payload.Description == nil {
+		op.ClearDescription()
+	} else {
+		op.SetDescription(*payload.Description)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) PermissionDelete(ctx echo.Context, id int) error {
+	return h.client.Permission.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) PermissionList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.Permission.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(permission.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Name",
+			"Description",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Name,
+				res[i].Description,
+				formatTime(res[i].CreatedAt, h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) PermissionGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Permission.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("name", entity.Name)
+	v.Set("description", entity.Description)
+	return v, err
+}
+
+func (h *Handler) RecoveryCodeCreate(ctx echo.Context) error {
+	var payload RecoveryCode
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.RecoveryCode.Create()
+	if payload.Code != nil {
+		op.SetCode(*payload.Code)
+	}
+	op.SetUserID(payload.UserID)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) RecoveryCodeUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.RecoveryCode.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload RecoveryCode
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	if payload.Code != nil {
+		op.SetCode(*payload.Code)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) RecoveryCodeDelete(ctx echo.Context, id int) error {
+	return h.client.RecoveryCode.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) RecoveryCodeList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.RecoveryCode.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(recoverycode.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"User ID",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				fmt.Sprint(res[i].UserID),
+				formatTime(res[i].CreatedAt, h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) RecoveryCodeGet(ctx echo.Context, id int) (url.Values, error) {
+	_, err := h.client.RecoveryCode.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	return v, err
+}
+
+func (h *Handler) RememberTokenCreate(ctx echo.Context) error {
+	var payload RememberToken
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.RememberToken.Create()
+	op.SetSeries(payload.Series)
 	if payload.Token != nil {
 		op.SetToken(*payload.Token)
 	}
+	if payload.PreviousToken != nil {
+		op.SetPreviousToken(*payload.PreviousToken)
+	}
+	if payload.SessionToken != nil {
+		op.SetSessionToken(*payload.SessionToken)
+	}
 	op.SetUserID(payload.UserID)
 	if payload.CreatedAt != nil {
 		op.SetCreatedAt(*payload.CreatedAt)
 	}
+	if payload.RotatedAt != nil {
+		op.SetRotatedAt(*payload.RotatedAt)
+	}
 	_, err := op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) PasswordTokenUpdate(ctx echo.Context, id int) error {
-	entity, err := h.client.PasswordToken.Get(ctx.Request().Context(), id)
+func (h *Handler) RememberTokenUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.RememberToken.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return err
 	}
 
-	var payload PasswordToken
+	var payload RememberToken
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	if payload.Token != nil {
+		op.SetToken(*payload.Token)
+	}
+	if payload.PreviousToken != nil {
+		op.SetPreviousToken(*payload.PreviousToken)
+	}
+	if payload.SessionToken != nil {
+		op.SetSessionToken(*payload.SessionToken)
+	}
+	if payload.RotatedAt == nil {
+		op.ClearRotatedAt()
+	} else {
+		op.SetRotatedAt(*payload.RotatedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) RememberTokenDelete(ctx echo.Context, id int) error {
+	return h.client.RememberToken.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) RememberTokenList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.RememberToken.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(remembertoken.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Series",
+			"User ID",
+			"Created at",
+			"Rotated at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Series,
+				fmt.Sprint(res[i].UserID),
+				formatTime(res[i].CreatedAt, h.Config.TimeFormat),
+				formatTime(res[i].RotatedAt, h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) RememberTokenGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.RememberToken.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("rotated_at", formatTime(entity.RotatedAt, dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) RoleCreate(ctx echo.Context) error {
+	var payload Role
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.Role.Create()
+	op.SetName(payload.Name)
+	if payload.Description != nil {
+		op.SetDescription(*payload.Description)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) RoleUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Role.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload Role
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetName(payload.Name)
+	if payload.Description == nil {
+		op.ClearDescription()
+	} else {
+		op.SetDescription(*payload.Description)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) RoleDelete(ctx echo.Context, id int) error {
+	return h.client.Role.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) RoleList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.Role.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(role.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Name",
+			"Description",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Name,
+				res[i].Description,
+				formatTime(res[i].CreatedAt, h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) RoleGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Role.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("name", entity.Name)
+	v.Set("description", entity.Description)
+	return v, err
+}
+
+func (h *Handler) RolePermissionCreate(ctx echo.Context) error {
+	var payload RolePermission
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.RolePermission.Create()
+	op.SetRoleID(payload.RoleID)
+	op.SetPermissionID(payload.PermissionID)
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) RolePermissionUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.RolePermission.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload RolePermission
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetRoleID(payload.RoleID)
+	op.SetPermissionID(payload.PermissionID)
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) RolePermissionDelete(ctx echo.Context, id int) error {
+	return h.client.RolePermission.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) RolePermissionList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.RolePermission.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(rolepermission.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Role ID",
+			"Permission ID",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				fmt.Sprint(res[i].RoleID),
+				fmt.Sprint(res[i].PermissionID),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) RolePermissionGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.RolePermission.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("role_id", fmt.Sprint(entity.RoleID))
+	v.Set("permission_id", fmt.Sprint(entity.PermissionID))
+	return v, err
+}
+
+func (h *Handler) SessionCreate(ctx echo.Context) error {
+	var payload Session
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.Session.Create()
+	if payload.Token != nil {
+		op.SetToken(*payload.Token)
+	}
+	op.SetName(payload.Name)
+	if payload.Data != nil {
+		op.SetData(*payload.Data)
+	}
+	if payload.UserID != nil {
+		op.SetUserID(*payload.UserID)
+	}
+	if payload.IP != nil {
+		op.SetIP(*payload.IP)
+	}
+	if payload.UserAgent != nil {
+		op.SetUserAgent(*payload.UserAgent)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.LastSeenAt != nil {
+		op.SetLastSeenAt(*payload.LastSeenAt)
+	}
+	op.SetExpiresAt(payload.ExpiresAt)
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) SessionUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Session.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload Session
 	if err = h.bind(ctx, &payload); err != nil {
 		return err
 	}
@@ -118,29 +1400,47 @@ func (h *Handler) PasswordTokenUpdate(ctx echo.Context, id int) error {
 	if payload.Token != nil {
 		op.SetToken(*payload.Token)
 	}
-	op.SetUserID(payload.UserID)
-	if payload.CreatedAt == nil {
+	if payload.Data != nil {
+		op.SetData(*payload.Data)
+	}
+	if payload.UserID == nil {
+		op.ClearUserID()
+	} else {
+		op.SetUserID(*payload.UserID)
+	}
+	if payload.IP == nil {
+		op.ClearIP()
+	} else {
+		op.SetIP(*payload.IP)
+	}
+	if payload.UserAgent == nil {
+		op.ClearUserAgent()
+	} else {
+		op.SetUserAgent(*payload.UserAgent)
+	}
+	if payload.LastSeenAt == nil {
 		var empty time.Time
-		op.SetCreatedAt(empty)
+		op.SetLastSeenAt(empty)
 	} else {
-		op.SetCreatedAt(*payload.CreatedAt)
+		op.SetLastSeenAt(*payload.LastSeenAt)
 	}
+	op.SetExpiresAt(payload.ExpiresAt)
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) PasswordTokenDelete(ctx echo.Context, id int) error {
-	return h.client.PasswordToken.DeleteOneID(id).
+func (h *Handler) SessionDelete(ctx echo.Context, id int) error {
+	return h.client.Session.DeleteOneID(id).
 		Exec(ctx.Request().Context())
 }
 
-func (h *Handler) PasswordTokenList(ctx echo.Context) (*EntityList, error) {
+func (h *Handler) SessionList(ctx echo.Context) (*EntityList, error) {
 	page, offset := h.getPageAndOffset(ctx)
-	res, err := h.client.PasswordToken.
+	res, err := h.client.Session.
 		Query().
 		Limit(h.Config.ItemsPerPage + 1).
 		Offset(offset).
-		Order(passwordtoken.ByID(sql.OrderDesc())).
+		Order(session.ByID(sql.OrderDesc())).
 		All(ctx.Request().Context())
 
 	if err != nil {
@@ -149,8 +1449,13 @@ func (h *Handler) PasswordTokenList(ctx echo.Context) (*EntityList, error) {
 
 	list := &EntityList{
 		Columns: []string{
+			"Name",
 			"User ID",
+			"Ip",
+			"User agent",
 			"Created at",
+			"Last seen at",
+			"Expires at",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
 		Page:        page,
@@ -161,8 +1466,13 @@ func (h *Handler) PasswordTokenList(ctx echo.Context) (*EntityList, error) {
 		list.Entities = append(list.Entities, EntityValues{
 			ID: res[i].ID,
 			Values: []string{
+				res[i].Name,
 				fmt.Sprint(res[i].UserID),
-				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].IP,
+				res[i].UserAgent,
+				formatTime(res[i].CreatedAt, h.Config.TimeFormat),
+				formatTime(res[i].LastSeenAt, h.Config.TimeFormat),
+				formatTime(res[i].ExpiresAt, h.Config.TimeFormat),
 			},
 		})
 	}
@@ -170,15 +1480,18 @@ func (h *Handler) PasswordTokenList(ctx echo.Context) (*EntityList, error) {
 	return list, err
 }
 
-func (h *Handler) PasswordTokenGet(ctx echo.Context, id int) (url.Values, error) {
-	entity, err := h.client.PasswordToken.Get(ctx.Request().Context(), id)
+func (h *Handler) SessionGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Session.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return nil, err
 	}
 
 	v := url.Values{}
 	v.Set("user_id", fmt.Sprint(entity.UserID))
-	v.Set("created_at", entity.CreatedAt.Format(dateTimeFormat))
+	v.Set("ip", entity.IP)
+	v.Set("user_agent", entity.UserAgent)
+	v.Set("last_seen_at", formatTime(entity.LastSeenAt, dateTimeFormat))
+	v.Set("expires_at", formatTime(entity.ExpiresAt, dateTimeFormat))
 	return v, err
 }
 
@@ -196,6 +1509,15 @@ func (h *Handler) UserCreate(ctx echo.Context) error {
 	}
 	op.SetVerified(payload.Verified)
 	op.SetAdmin(payload.Admin)
+	if payload.LockedUntil != nil {
+		op.SetLockedUntil(*payload.LockedUntil)
+	}
+	if payload.TotpSecret != nil {
+		op.SetTotpSecret(*payload.TotpSecret)
+	}
+	if payload.TotpLastStep != nil {
+		op.SetTotpLastStep(*payload.TotpLastStep)
+	}
 	if payload.CreatedAt != nil {
 		op.SetCreatedAt(*payload.CreatedAt)
 	}
@@ -222,6 +1544,19 @@ func (h *Handler) UserUpdate(ctx echo.Context, id int) error {
 	}
 	op.SetVerified(payload.Verified)
 	op.SetAdmin(payload.Admin)
+	if payload.LockedUntil == nil {
+		op.ClearLockedUntil()
+	} else {
+		op.SetLockedUntil(*payload.LockedUntil)
+	}
+	if payload.TotpSecret != nil {
+		op.SetTotpSecret(*payload.TotpSecret)
+	}
+	if payload.TotpLastStep == nil {
+		op.ClearTotpLastStep()
+	} else {
+		op.SetTotpLastStep(*payload.TotpLastStep)
+	}
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
@@ -250,6 +1585,8 @@ func (h *Handler) UserList(ctx echo.Context) (*EntityList, error) {
 			"Email",
 			"Verified",
 			"Admin",
+			"Locked until",
+			"Totp last step",
 			"Created at",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
@@ -265,7 +1602,9 @@ func (h *Handler) UserList(ctx echo.Context) (*EntityList, error) {
 				res[i].Email,
 				fmt.Sprint(res[i].Verified),
 				fmt.Sprint(res[i].Admin),
-				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				formatTime(res[i].LockedUntil, h.Config.TimeFormat),
+				fmt.Sprint(res[i].TotpLastStep),
+				formatTime(res[i].CreatedAt, h.Config.TimeFormat),
 			},
 		})
 	}
@@ -284,6 +1623,343 @@ func (h *Handler) UserGet(ctx echo.Context, id int) (url.Values, error) {
 	v.Set("email", entity.Email)
 	v.Set("verified", fmt.Sprint(entity.Verified))
 	v.Set("admin", fmt.Sprint(entity.Admin))
+	v.Set("locked_until", formatTime(entity.LockedUntil, dateTimeFormat))
+	v.Set("totp_last_step", fmt.Sprint(entity.TotpLastStep))
+	return v, err
+}
+
+func (h *Handler) UserIdentityCreate(ctx echo.Context) error {
+	var payload UserIdentity
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.UserIdentity.Create()
+	op.SetProvider(payload.Provider)
+	op.SetSubject(payload.Subject)
+	if payload.Email != nil {
+		op.SetEmail(*payload.Email)
+	}
+	op.SetUserID(payload.UserID)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.LastLoginAt != nil {
+		op.SetLastLoginAt(*payload.LastLoginAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) UserIdentityUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.UserIdentity.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload UserIdentity
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	if payload.Email == nil {
+		op.ClearEmail()
+	} else {
+		op.SetEmail(*payload.Email)
+	}
+	if payload.LastLoginAt == nil {
+		var empty time.Time
+		op.SetLastLoginAt(empty)
+	} else {
+		op.SetLastLoginAt(*payload.LastLoginAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) UserIdentityDelete(ctx echo.Context, id int) error {
+	return h.client.UserIdentity.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) UserIdentityList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.UserIdentity.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(useridentity.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Provider",
+			"Subject",
+			"Email",
+			"User ID",
+			"Created at",
+			"Last login at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Provider,
+				res[i].Subject,
+				res[i].Email,
+				fmt.Sprint(res[i].UserID),
+				formatTime(res[i].CreatedAt, h.Config.TimeFormat),
+				formatTime(res[i].LastLoginAt, h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) UserIdentityGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.UserIdentity.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("email", entity.Email)
+	v.Set("last_login_at", formatTime(entity.LastLoginAt, dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) UserRoleCreate(ctx echo.Context) error {
+	var payload UserRole
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.UserRole.Create()
+	op.SetUserID(payload.UserID)
+	op.SetRoleID(payload.RoleID)
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) UserRoleUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.UserRole.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload UserRole
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetUserID(payload.UserID)
+	op.SetRoleID(payload.RoleID)
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) UserRoleDelete(ctx echo.Context, id int) error {
+	return h.client.UserRole.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) UserRoleList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.UserRole.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(userrole.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"User ID",
+			"Role ID",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				fmt.Sprint(res[i].UserID),
+				fmt.Sprint(res[i].RoleID),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) UserRoleGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.UserRole.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("user_id", fmt.Sprint(entity.UserID))
+	v.Set("role_id", fmt.Sprint(entity.RoleID))
+	return v, err
+}
+
+func (h *Handler) WebAuthnCredentialCreate(ctx echo.Context) error {
+	var payload WebAuthnCredential
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.WebAuthnCredential.Create()
+	op.SetName(payload.Name)
+	op.SetCredentialID(payload.CredentialID)
+	if payload.PublicKey != nil {
+		op.SetPublicKey(*payload.PublicKey)
+	}
+	if payload.AttestationType != nil {
+		op.SetAttestationType(*payload.AttestationType)
+	}
+	if payload.Transports != nil {
+		op.SetTransports(*payload.Transports)
+	}
+	if payload.SignCount != nil {
+		op.SetSignCount(*payload.SignCount)
+	}
+	op.SetBackupEligible(payload.BackupEligible)
+	op.SetBackupState(payload.BackupState)
+	op.SetUserID(payload.UserID)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.LastUsedAt != nil {
+		op.SetLastUsedAt(*payload.LastUsedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) WebAuthnCredentialUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.WebAuthnCredential.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload WebAuthnCredential
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetName(payload.Name)
+	if payload.Transports == nil {
+		op.ClearTransports()
+	} else {
+		op.SetTransports(*payload.Transports)
+	}
+	if payload.SignCount == nil {
+		var empty uint32
+		op.SetSignCount(empty)
+	} else {
+		op.SetSignCount(*payload.SignCount)
+	}
+	op.SetBackupState(payload.BackupState)
+	if payload.LastUsedAt == nil {
+		op.ClearLastUsedAt()
+	} else {
+		op.SetLastUsedAt(*payload.LastUsedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) WebAuthnCredentialDelete(ctx echo.Context, id int) error {
+	return h.client.WebAuthnCredential.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) WebAuthnCredentialList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.WebAuthnCredential.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(webauthncredential.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Name",
+			"Credential ID",
+			"Attestation type",
+			"Transports",
+			"Sign count",
+			"Backup eligible",
+			"Backup state",
+			"User ID",
+			"Created at",
+			"Last used at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Name,
+				res[i].CredentialID,
+				res[i].AttestationType,
+				fmt.Sprint(res[i].Transports),
+				fmt.Sprint(res[i].SignCount),
+				fmt.Sprint(res[i].BackupEligible),
+				fmt.Sprint(res[i].BackupState),
+				fmt.Sprint(res[i].UserID),
+				formatTime(res[i].CreatedAt, h.Config.TimeFormat),
+				formatTime(res[i].LastUsedAt, h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) WebAuthnCredentialGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.WebAuthnCredential.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("name", entity.Name)
+	v.Set("transports", fmt.Sprint(entity.Transports))
+	v.Set("sign_count", fmt.Sprint(entity.SignCount))
+	v.Set("backup_state", fmt.Sprint(entity.BackupState))
+	v.Set("last_used_at", formatTime(entity.LastUsedAt, dateTimeFormat))
 	return v, err
 }
 
@@ -296,6 +1972,14 @@ func (h *Handler) getPageAndOffset(ctx echo.Context) (int, int) {
 	return 1, 0
 }
 
+// formatTime formats a given time, leaving unset times empty.
+func formatTime(t time.Time, format string) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(format)
+}
+
 func (h *Handler) bind(ctx echo.Context, entity any) error {
 	// Echo requires some pre-processing of form values to avoid problems.
 	for k, v := range ctx.Request().Form {
@@ -1,7 +1,55 @@
 // Code generated by ent, DO NOT EDIT.
 package admin
 
-import "time"
+import (
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent/impersonation"
+)
+
+type APIToken struct {
+	Name       string     `form:"name"`
+	Token      *string    `form:"token"`
+	Scopes     *[]string  `form:"scopes"`
+	UserID     int        `form:"user_id"`
+	CreatedAt  *time.Time `form:"created_at"`
+	ExpiresAt  *time.Time `form:"expires_at"`
+	LastUsedAt *time.Time `form:"last_used_at"`
+}
+
+type Impersonation struct {
+	Action     impersonation.Action `form:"action"`
+	AdminID    *int                 `form:"admin_id"`
+	UserID     *int                 `form:"user_id"`
+	AdminEmail string               `form:"admin_email"`
+	UserEmail  string               `form:"user_email"`
+	IP         *string              `form:"ip"`
+	CreatedAt  *time.Time           `form:"created_at"`
+}
+
+type Invitation struct {
+	Email      string     `form:"email"`
+	Token      *string    `form:"token"`
+	InviterID  *int       `form:"inviter_id"`
+	UserID     *int       `form:"user_id"`
+	CreatedAt  *time.Time `form:"created_at"`
+	ExpiresAt  time.Time  `form:"expires_at"`
+	AcceptedAt *time.Time `form:"accepted_at"`
+}
+
+type LoginAttempt struct {
+	Email     string     `form:"email"`
+	IP        string     `form:"ip"`
+	Success   bool       `form:"success"`
+	UserID    *int       `form:"user_id"`
+	CreatedAt *time.Time `form:"created_at"`
+}
+
+type MagicLink struct {
+	Jti       *string    `form:"jti"`
+	UserID    int        `form:"user_id"`
+	CreatedAt *time.Time `form:"created_at"`
+}
 
 type PasswordToken struct {
 	Token     *string    `form:"token"`
@@ -9,15 +57,91 @@ type PasswordToken struct {
 	CreatedAt *time.Time `form:"created_at"`
 }
 
-type User struct {
-	Name      string     `form:"name"`
-	Email     string     `form:"email"`
-	Password  *string    `form:"password"`
-	Verified  bool       `form:"verified"`
-	Admin     bool       `form:"admin"`
+type Permission struct {
+	Name        string     `form:"name"`
+	Description *string    `form:"description"`
+	CreatedAt   *time.Time `form:"created_at"`
+}
+
+type RecoveryCode struct {
+	Code      *string    `form:"code"`
+	UserID    int        `form:"user_id"`
 	CreatedAt *time.Time `form:"created_at"`
 }
 
+type RememberToken struct {
+	Series        string     `form:"series"`
+	Token         *string    `form:"token"`
+	PreviousToken *string    `form:"previous_token"`
+	SessionToken  *string    `form:"session_token"`
+	UserID        int        `form:"user_id"`
+	CreatedAt     *time.Time `form:"created_at"`
+	RotatedAt     *time.Time `form:"rotated_at"`
+}
+
+type Role struct {
+	Name        string     `form:"name"`
+	Description *string    `form:"description"`
+	CreatedAt   *time.Time `form:"created_at"`
+}
+
+type RolePermission struct {
+	RoleID       int `form:"role_id"`
+	PermissionID int `form:"permission_id"`
+}
+
+type Session struct {
+	Token      *string    `form:"token"`
+	Name       string     `form:"name"`
+	Data       *string    `form:"data"`
+	UserID     *int       `form:"user_id"`
+	IP         *string    `form:"ip"`
+	UserAgent  *string    `form:"user_agent"`
+	CreatedAt  *time.Time `form:"created_at"`
+	LastSeenAt *time.Time `form:"last_seen_at"`
+	ExpiresAt  time.Time  `form:"expires_at"`
+}
+
+type User struct {
+	Name         string     `form:"name"`
+	Email        string     `form:"email"`
+	Password     *string    `form:"password"`
+	Verified     bool       `form:"verified"`
+	Admin        bool       `form:"admin"`
+	LockedUntil  *time.Time `form:"locked_until"`
+	TotpSecret   *string    `form:"totp_secret"`
+	TotpLastStep *int64     `form:"totp_last_step"`
+	CreatedAt    *time.Time `form:"created_at"`
+}
+
+type UserIdentity struct {
+	Provider    string     `form:"provider"`
+	Subject     string     `form:"subject"`
+	Email       *string    `form:"email"`
+	UserID      int        `form:"user_id"`
+	CreatedAt   *time.Time `form:"created_at"`
+	LastLoginAt *time.Time `form:"last_login_at"`
+}
+
+type UserRole struct {
+	UserID int `form:"user_id"`
+	RoleID int `form:"role_id"`
+}
+
+type WebAuthnCredential struct {
+	Name            string     `form:"name"`
+	CredentialID    string     `form:"credential_id"`
+	PublicKey       *[]byte    `form:"public_key"`
+	AttestationType *string    `form:"attestation_type"`
+	Transports      *[]string  `form:"transports"`
+	SignCount       *uint32    `form:"sign_count"`
+	BackupEligible  bool       `form:"backup_eligible"`
+	BackupState     bool       `form:"backup_state"`
+	UserID          int        `form:"user_id"`
+	CreatedAt       *time.Time `form:"created_at"`
+	LastUsedAt      *time.Time `form:"last_used_at"`
+}
+
 type EntityList struct {
 	Columns     []string
 	Entities    []EntityValues
@@ -38,7 +162,21 @@ type HandlerConfig struct {
 
 func GetEntityTypeNames() []string {
 	return []string{
+		"APIToken",
+		"Impersonation",
+		"Invitation",
+		"LoginAttempt",
+		"MagicLink",
 		"PasswordToken",
+		"Permission",
+		"RecoveryCode",
+		"RememberToken",
+		"Role",
+		"RolePermission",
+		"Session",
 		"User",
+		"UserIdentity",
+		"UserRole",
+		"WebAuthnCredential",
 	}
 }
@@ -12,8 +12,22 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mikestefanello/pagoda/ent/apitoken"
+	"github.com/mikestefanello/pagoda/ent/impersonation"
+	"github.com/mikestefanello/pagoda/ent/invitation"
+	"github.com/mikestefanello/pagoda/ent/loginattempt"
+	"github.com/mikestefanello/pagoda/ent/magiclink"
 	"github.com/mikestefanello/pagoda/ent/passwordtoken"
+	"github.com/mikestefanello/pagoda/ent/permission"
+	"github.com/mikestefanello/pagoda/ent/recoverycode"
+	"github.com/mikestefanello/pagoda/ent/remembertoken"
+	"github.com/mikestefanello/pagoda/ent/role"
+	"github.com/mikestefanello/pagoda/ent/rolepermission"
+	"github.com/mikestefanello/pagoda/ent/session"
 	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/ent/useridentity"
+	"github.com/mikestefanello/pagoda/ent/userrole"
+	"github.com/mikestefanello/pagoda/ent/webauthncredential"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -74,8 +88,22 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			passwordtoken.Table: passwordtoken.ValidColumn,
-			user.Table:          user.ValidColumn,
+			apitoken.Table:           apitoken.ValidColumn,
+			impersonation.Table:      impersonation.ValidColumn,
+			invitation.Table:         invitation.ValidColumn,
+			loginattempt.Table:       loginattempt.ValidColumn,
+			magiclink.Table:          magiclink.ValidColumn,
+			passwordtoken.Table:      passwordtoken.ValidColumn,
+			permission.Table:         permission.ValidColumn,
+			recoverycode.Table:       recoverycode.ValidColumn,
+			remembertoken.Table:      remembertoken.ValidColumn,
+			role.Table:               role.ValidColumn,
+			rolepermission.Table:     rolepermission.ValidColumn,
+			session.Table:            session.ValidColumn,
+			user.Table:               user.ValidColumn,
+			useridentity.Table:       useridentity.ValidColumn,
+			userrole.Table:           userrole.ValidColumn,
+			webauthncredential.Table: webauthncredential.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/impersonation"
+	"github.com/mikestefanello/pagoda/ent/user"
+)
+
+// Impersonation is the model entity for the Impersonation schema.
+type Impersonation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action impersonation.Action `json:"action,omitempty"`
+	// AdminID holds the value of the "admin_id" field.
+	AdminID int `json:"admin_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// AdminEmail holds the value of the "admin_email" field.
+	AdminEmail string `json:"admin_email,omitempty"`
+	// UserEmail holds the value of the "user_email" field.
+	UserEmail string `json:"user_email,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ImpersonationQuery when eager-loading is set.
+	Edges        ImpersonationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ImpersonationEdges holds the relations/edges for other nodes in the graph.
+type ImpersonationEdges struct {
+	// Admin holds the value of the admin edge.
+	Admin *User `json:"admin,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AdminOrErr returns the Admin value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImpersonationEdges) AdminOrErr() (*User, error) {
+	if e.Admin != nil {
+		return e.Admin, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "admin"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImpersonationEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Impersonation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case impersonation.FieldID, impersonation.FieldAdminID, impersonation.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case impersonation.FieldAction, impersonation.FieldAdminEmail, impersonation.FieldUserEmail, impersonation.FieldIP:
+			values[i] = new(sql.NullString)
+		case impersonation.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Impersonation fields.
+func (i *Impersonation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case impersonation.FieldID:
+			value, ok := values[j].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			i.ID = int(value.Int64)
+		case impersonation.FieldAction:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[j])
+			} else if value.Valid {
+				i.Action = impersonation.Action(value.String)
+			}
+		case impersonation.FieldAdminID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_id", values[j])
+			} else if value.Valid {
+				i.AdminID = int(value.Int64)
+			}
+		case impersonation.FieldUserID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[j])
+			} else if value.Valid {
+				i.UserID = int(value.Int64)
+			}
+		case impersonation.FieldAdminEmail:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_email", values[j])
+			} else if value.Valid {
+				i.AdminEmail = value.String
+			}
+		case impersonation.FieldUserEmail:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_email", values[j])
+			} else if value.Valid {
+				i.UserEmail = value.String
+			}
+		case impersonation.FieldIP:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[j])
+			} else if value.Valid {
+				i.IP = value.String
+			}
+		case impersonation.FieldCreatedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[j])
+			} else if value.Valid {
+				i.CreatedAt = value.Time
+			}
+		default:
+			i.selectValues.Set(columns[j], values[j])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Impersonation.
+// This includes values selected through modifiers, order, etc.
+func (i *Impersonation) Value(name string) (ent.Value, error) {
+	return i.selectValues.Get(name)
+}
+
+// QueryAdmin queries the "admin" edge of the Impersonation entity.
+func (i *Impersonation) QueryAdmin() *UserQuery {
+	return NewImpersonationClient(i.config).QueryAdmin(i)
+}
+
+// QueryUser queries the "user" edge of the Impersonation entity.
+func (i *Impersonation) QueryUser() *UserQuery {
+	return NewImpersonationClient(i.config).QueryUser(i)
+}
+
+// Update returns a builder for updating this Impersonation.
+// Note that you need to call Impersonation.Unwrap() before calling this method if this Impersonation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Impersonation) Update() *ImpersonationUpdateOne {
+	return NewImpersonationClient(i.config).UpdateOne(i)
+}
+
+// Unwrap unwraps the Impersonation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (i *Impersonation) Unwrap() *Impersonation {
+	_tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Impersonation is not a transactional entity")
+	}
+	i.config.driver = _tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Impersonation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Impersonation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", i.ID))
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", i.Action))
+	builder.WriteString(", ")
+	builder.WriteString("admin_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.AdminID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("admin_email=")
+	builder.WriteString(i.AdminEmail)
+	builder.WriteString(", ")
+	builder.WriteString("user_email=")
+	builder.WriteString(i.UserEmail)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(i.IP)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(i.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Impersonations is a parsable slice of Impersonation.
+type Impersonations []*Impersonation
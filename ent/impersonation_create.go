@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/impersonation"
+	"github.com/mikestefanello/pagoda/ent/user"
+)
+
+// ImpersonationCreate is the builder for creating a Impersonation entity.
+type ImpersonationCreate struct {
+	config
+	mutation *ImpersonationMutation
+	hooks    []Hook
+}
+
+// SetAction sets the "action" field.
+func (ic *ImpersonationCreate) SetAction(i impersonation.Action) *ImpersonationCreate {
+	ic.mutation.SetAction(i)
+	return ic
+}
+
+// SetAdminID sets the "admin_id" field.
+func (ic *ImpersonationCreate) SetAdminID(i int) *ImpersonationCreate {
+	ic.mutation.SetAdminID(i)
+	return ic
+}
+
+// SetNillableAdminID sets the "admin_id" field if the given value is not nil.
+func (ic *ImpersonationCreate) SetNillableAdminID(i *int) *ImpersonationCreate {
+	if i != nil {
+		ic.SetAdminID(*i)
+	}
+	return ic
+}
+
+// SetUserID sets the "user_id" field.
+func (ic *ImpersonationCreate) SetUserID(i int) *ImpersonationCreate {
+	ic.mutation.SetUserID(i)
+	return ic
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ic *ImpersonationCreate) SetNillableUserID(i *int) *ImpersonationCreate {
+	if i != nil {
+		ic.SetUserID(*i)
+	}
+	return ic
+}
+
+// SetAdminEmail sets the "admin_email" field.
+func (ic *ImpersonationCreate) SetAdminEmail(s string) *ImpersonationCreate {
+	ic.mutation.SetAdminEmail(s)
+	return ic
+}
+
+// SetUserEmail sets the "user_email" field.
+func (ic *ImpersonationCreate) SetUserEmail(s string) *ImpersonationCreate {
+	ic.mutation.SetUserEmail(s)
+	return ic
+}
+
+// SetIP sets the "ip" field.
+func (ic *ImpersonationCreate) SetIP(s string) *ImpersonationCreate {
+	ic.mutation.SetIP(s)
+	return ic
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (ic *ImpersonationCreate) SetNillableIP(s *string) *ImpersonationCreate {
+	if s != nil {
+		ic.SetIP(*s)
+	}
+	return ic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *ImpersonationCreate) SetCreatedAt(t time.Time) *ImpersonationCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *ImpersonationCreate) SetNillableCreatedAt(t *time.Time) *ImpersonationCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetAdmin sets the "admin" edge to the User entity.
+func (ic *ImpersonationCreate) SetAdmin(u *User) *ImpersonationCreate {
+	return ic.SetAdminID(u.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ic *ImpersonationCreate) SetUser(u *User) *ImpersonationCreate {
+	return ic.SetUserID(u.ID)
+}
+
+// Mutation returns the ImpersonationMutation object of the builder.
+func (ic *ImpersonationCreate) Mutation() *ImpersonationMutation {
+	return ic.mutation
+}
+
+// Save creates the Impersonation in the database.
+func (ic *ImpersonationCreate) Save(ctx context.Context) (*Impersonation, error) {
+	ic.defaults()
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *ImpersonationCreate) SaveX(ctx context.Context) *Impersonation {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *ImpersonationCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *ImpersonationCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *ImpersonationCreate) defaults() {
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		v := impersonation.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *ImpersonationCreate) check() error {
+	if _, ok := ic.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "Impersonation.action"`)}
+	}
+	if v, ok := ic.mutation.Action(); ok {
+		if err := impersonation.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "Impersonation.action": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.AdminEmail(); !ok {
+		return &ValidationError{Name: "admin_email", err: errors.New(`ent: missing required field "Impersonation.admin_email"`)}
+	}
+	if _, ok := ic.mutation.UserEmail(); !ok {
+		return &ValidationError{Name: "user_email", err: errors.New(`ent: missing required field "Impersonation.user_email"`)}
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Impersonation.created_at"`)}
+	}
+	return nil
+}
+
+func (ic *ImpersonationCreate) sqlSave(ctx context.Context) (*Impersonation, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *ImpersonationCreate) createSpec() (*Impersonation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Impersonation{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(impersonation.Table, sqlgraph.NewFieldSpec(impersonation.FieldID, field.TypeInt))
+	)
+	if value, ok := ic.mutation.Action(); ok {
+		_spec.SetField(impersonation.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := ic.mutation.AdminEmail(); ok {
+		_spec.SetField(impersonation.FieldAdminEmail, field.TypeString, value)
+		_node.AdminEmail = value
+	}
+	if value, ok := ic.mutation.UserEmail(); ok {
+		_spec.SetField(impersonation.FieldUserEmail, field.TypeString, value)
+		_node.UserEmail = value
+	}
+	if value, ok := ic.mutation.IP(); ok {
+		_spec.SetField(impersonation.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.SetField(impersonation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ic.mutation.AdminIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   impersonation.AdminTable,
+			Columns: []string{impersonation.AdminColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AdminID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   impersonation.UserTable,
+			Columns: []string{impersonation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ImpersonationCreateBulk is the builder for creating many Impersonation entities in bulk.
+type ImpersonationCreateBulk struct {
+	config
+	err      error
+	builders []*ImpersonationCreate
+}
+
+// Save creates the Impersonation entities in the database.
+func (icb *ImpersonationCreateBulk) Save(ctx context.Context) ([]*Impersonation, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Impersonation, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImpersonationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *ImpersonationCreateBulk) SaveX(ctx context.Context) []*Impersonation {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *ImpersonationCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *ImpersonationCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/loginattempt"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/user"
+)
+
+// LoginAttemptUpdate is the builder for updating LoginAttempt entities.
+type LoginAttemptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginAttemptMutation
+}
+
+// Where appends a list predicates to the LoginAttemptUpdate builder.
+func (lau *LoginAttemptUpdate) Where(ps ...predicate.LoginAttempt) *LoginAttemptUpdate {
+	lau.mutation.Where(ps...)
+	return lau
+}
+
+// SetEmail sets the "email" field.
+func (lau *LoginAttemptUpdate) SetEmail(s string) *LoginAttemptUpdate {
+	lau.mutation.SetEmail(s)
+	return lau
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (lau *LoginAttemptUpdate) SetNillableEmail(s *string) *LoginAttemptUpdate {
+	if s != nil {
+		lau.SetEmail(*s)
+	}
+	return lau
+}
+
+// SetIP sets the "ip" field.
+func (lau *LoginAttemptUpdate) SetIP(s string) *LoginAttemptUpdate {
+	lau.mutation.SetIP(s)
+	return lau
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (lau *LoginAttemptUpdate) SetNillableIP(s *string) *LoginAttemptUpdate {
+	if s != nil {
+		lau.SetIP(*s)
+	}
+	return lau
+}
+
+// SetSuccess sets the "success" field.
+func (lau *LoginAttemptUpdate) SetSuccess(b bool) *LoginAttemptUpdate {
+	lau.mutation.SetSuccess(b)
+	return lau
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (lau *LoginAttemptUpdate) SetNillableSuccess(b *bool) *LoginAttemptUpdate {
+	if b != nil {
+		lau.SetSuccess(*b)
+	}
+	return lau
+}
+
+// SetUserID sets the "user_id" field.
+func (lau *LoginAttemptUpdate) SetUserID(i int) *LoginAttemptUpdate {
+	lau.mutation.SetUserID(i)
+	return lau
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (lau *LoginAttemptUpdate) SetNillableUserID(i *int) *LoginAttemptUpdate {
+	if i != nil {
+		lau.SetUserID(*i)
+	}
+	return lau
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (lau *LoginAttemptUpdate) ClearUserID() *LoginAttemptUpdate {
+	lau.mutation.ClearUserID()
+	return lau
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (lau *LoginAttemptUpdate) SetUser(u *User) *LoginAttemptUpdate {
+	return lau.SetUserID(u.ID)
+}
+
+// Mutation returns the LoginAttemptMutation object of the builder.
+func (lau *LoginAttemptUpdate) Mutation() *LoginAttemptMutation {
+	return lau.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (lau *LoginAttemptUpdate) ClearUser() *LoginAttemptUpdate {
+	lau.mutation.ClearUser()
+	return lau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lau *LoginAttemptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lau.sqlSave, lau.mutation, lau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lau *LoginAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := lau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lau *LoginAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := lau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lau *LoginAttemptUpdate) ExecX(ctx context.Context) {
+	if err := lau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lau *LoginAttemptUpdate) check() error {
+	if v, ok := lau.mutation.Email(); ok {
+		if err := loginattempt.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "LoginAttempt.email": %w`, err)}
+		}
+	}
+	if v, ok := lau.mutation.IP(); ok {
+		if err := loginattempt.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "LoginAttempt.ip": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lau *LoginAttemptUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginattempt.Table, loginattempt.Columns, sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeInt))
+	if ps := lau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lau.mutation.Email(); ok {
+		_spec.SetField(loginattempt.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := lau.mutation.IP(); ok {
+		_spec.SetField(loginattempt.FieldIP, field.TypeString, value)
+	}
+	if value, ok := lau.mutation.Success(); ok {
+		_spec.SetField(loginattempt.FieldSuccess, field.TypeBool, value)
+	}
+	if lau.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loginattempt.UserTable,
+			Columns: []string{loginattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lau.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loginattempt.UserTable,
+			Columns: []string{loginattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lau.mutation.done = true
+	return n, nil
+}
+
+// LoginAttemptUpdateOne is the builder for updating a single LoginAttempt entity.
+type LoginAttemptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginAttemptMutation
+}
+
+// SetEmail sets the "email" field.
+func (lauo *LoginAttemptUpdateOne) SetEmail(s string) *LoginAttemptUpdateOne {
+	lauo.mutation.SetEmail(s)
+	return lauo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (lauo *LoginAttemptUpdateOne) SetNillableEmail(s *string) *LoginAttemptUpdateOne {
+	if s != nil {
+		lauo.SetEmail(*s)
+	}
+	return lauo
+}
+
+// SetIP sets the "ip" field.
+func (lauo *LoginAttemptUpdateOne) SetIP(s string) *LoginAttemptUpdateOne {
+	lauo.mutation.SetIP(s)
+	return lauo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (lauo *LoginAttemptUpdateOne) SetNillableIP(s *string) *LoginAttemptUpdateOne {
+	if s != nil {
+		lauo.SetIP(*s)
+	}
+	return lauo
+}
+
+// SetSuccess sets the "success" field.
+func (lauo *LoginAttemptUpdateOne) SetSuccess(b bool) *LoginAttemptUpdateOne {
+	lauo.mutation.SetSuccess(b)
+	return lauo
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (lauo *LoginAttemptUpdateOne) SetNillableSuccess(b *bool) *LoginAttemptUpdateOne {
+	if b != nil {
+		lauo.SetSuccess(*b)
+	}
+	return lauo
+}
+
+// SetUserID sets the "user_id" field.
+func (lauo *LoginAttemptUpdateOne) SetUserID(i int) *LoginAttemptUpdateOne {
+	lauo.mutation.SetUserID(i)
+	return lauo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (lauo *LoginAttemptUpdateOne) SetNillableUserID(i *int) *LoginAttemptUpdateOne {
+	if i != nil {
+		lauo.SetUserID(*i)
+	}
+	return lauo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (lauo *LoginAttemptUpdateOne) ClearUserID() *LoginAttemptUpdateOne {
+	lauo.mutation.ClearUserID()
+	return lauo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (lauo *LoginAttemptUpdateOne) SetUser(u *User) *LoginAttemptUpdateOne {
+	return lauo.SetUserID(u.ID)
+}
+
+// Mutation returns the LoginAttemptMutation object of the builder.
+func (lauo *LoginAttemptUpdateOne) Mutation() *LoginAttemptMutation {
+	return lauo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (lauo *LoginAttemptUpdateOne) ClearUser() *LoginAttemptUpdateOne {
+	lauo.mutation.ClearUser()
+	return lauo
+}
+
+// Where appends a list predicates to the LoginAttemptUpdate builder.
+func (lauo *LoginAttemptUpdateOne) Where(ps ...predicate.LoginAttempt) *LoginAttemptUpdateOne {
+	lauo.mutation.Where(ps...)
+	return lauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lauo *LoginAttemptUpdateOne) Select(field string, fields ...string) *LoginAttemptUpdateOne {
+	lauo.fields = append([]string{field}, fields...)
+	return lauo
+}
+
+// Save executes the query and returns the updated LoginAttempt entity.
+func (lauo *LoginAttemptUpdateOne) Save(ctx context.Context) (*LoginAttempt, error) {
+	return withHooks(ctx, lauo.sqlSave, lauo.mutation, lauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lauo *LoginAttemptUpdateOne) SaveX(ctx context.Context) *LoginAttempt {
+	node, err := lauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lauo *LoginAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := lauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lauo *LoginAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := lauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lauo *LoginAttemptUpdateOne) check() error {
+	if v, ok := lauo.mutation.Email(); ok {
+		if err := loginattempt.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "LoginAttempt.email": %w`, err)}
+		}
+	}
+	if v, ok := lauo.mutation.IP(); ok {
+		if err := loginattempt.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "LoginAttempt.ip": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lauo *LoginAttemptUpdateOne) sqlSave(ctx context.Context) (_node *LoginAttempt, err error) {
+	if err := lauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginattempt.Table, loginattempt.Columns, sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeInt))
+	id, ok := lauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoginAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginattempt.FieldID)
+		for _, f := range fields {
+			if !loginattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loginattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lauo.mutation.Email(); ok {
+		_spec.SetField(loginattempt.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := lauo.mutation.IP(); ok {
+		_spec.SetField(loginattempt.FieldIP, field.TypeString, value)
+	}
+	if value, ok := lauo.mutation.Success(); ok {
+		_spec.SetField(loginattempt.FieldSuccess, field.TypeBool, value)
+	}
+	if lauo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loginattempt.UserTable,
+			Columns: []string{loginattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lauo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loginattempt.UserTable,
+			Columns: []string{loginattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LoginAttempt{config: lauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,156 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/magiclink"
+	"github.com/mikestefanello/pagoda/ent/user"
+)
+
+// MagicLink is the model entity for the MagicLink schema.
+type MagicLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Jti holds the value of the "jti" field.
+	Jti string `json:"-"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MagicLinkQuery when eager-loading is set.
+	Edges        MagicLinkEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MagicLinkEdges holds the relations/edges for other nodes in the graph.
+type MagicLinkEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MagicLinkEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MagicLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case magiclink.FieldID, magiclink.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case magiclink.FieldJti:
+			values[i] = new(sql.NullString)
+		case magiclink.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MagicLink fields.
+func (ml *MagicLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case magiclink.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ml.ID = int(value.Int64)
+		case magiclink.FieldJti:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field jti", values[i])
+			} else if value.Valid {
+				ml.Jti = value.String
+			}
+		case magiclink.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ml.UserID = int(value.Int64)
+			}
+		case magiclink.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ml.CreatedAt = value.Time
+			}
+		default:
+			ml.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MagicLink.
+// This includes values selected through modifiers, order, etc.
+func (ml *MagicLink) Value(name string) (ent.Value, error) {
+	return ml.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the MagicLink entity.
+func (ml *MagicLink) QueryUser() *UserQuery {
+	return NewMagicLinkClient(ml.config).QueryUser(ml)
+}
+
+// Update returns a builder for updating this MagicLink.
+// Note that you need to call MagicLink.Unwrap() before calling this method if this MagicLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ml *MagicLink) Update() *MagicLinkUpdateOne {
+	return NewMagicLinkClient(ml.config).UpdateOne(ml)
+}
+
+// Unwrap unwraps the MagicLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ml *MagicLink) Unwrap() *MagicLink {
+	_tx, ok := ml.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MagicLink is not a transactional entity")
+	}
+	ml.config.driver = _tx.drv
+	return ml
+}
+
+// String implements the fmt.Stringer.
+func (ml *MagicLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("MagicLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ml.ID))
+	builder.WriteString("jti=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ml.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ml.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MagicLinks is a parsable slice of MagicLink.
+type MagicLinks []*MagicLink
@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/magiclink"
+	"github.com/mikestefanello/pagoda/ent/user"
+)
+
+// MagicLinkCreate is the builder for creating a MagicLink entity.
+type MagicLinkCreate struct {
+	config
+	mutation *MagicLinkMutation
+	hooks    []Hook
+}
+
+// SetJti sets the "jti" field.
+func (mlc *MagicLinkCreate) SetJti(s string) *MagicLinkCreate {
+	mlc.mutation.SetJti(s)
+	return mlc
+}
+
+// SetUserID sets the "user_id" field.
+func (mlc *MagicLinkCreate) SetUserID(i int) *MagicLinkCreate {
+	mlc.mutation.SetUserID(i)
+	return mlc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mlc *MagicLinkCreate) SetCreatedAt(t time.Time) *MagicLinkCreate {
+	mlc.mutation.SetCreatedAt(t)
+	return mlc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mlc *MagicLinkCreate) SetNillableCreatedAt(t *time.Time) *MagicLinkCreate {
+	if t != nil {
+		mlc.SetCreatedAt(*t)
+	}
+	return mlc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mlc *MagicLinkCreate) SetUser(u *User) *MagicLinkCreate {
+	return mlc.SetUserID(u.ID)
+}
+
+// Mutation returns the MagicLinkMutation object of the builder.
+func (mlc *MagicLinkCreate) Mutation() *MagicLinkMutation {
+	return mlc.mutation
+}
+
+// Save creates the MagicLink in the database.
+func (mlc *MagicLinkCreate) Save(ctx context.Context) (*MagicLink, error) {
+	mlc.defaults()
+	return withHooks(ctx, mlc.sqlSave, mlc.mutation, mlc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mlc *MagicLinkCreate) SaveX(ctx context.Context) *MagicLink {
+	v, err := mlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mlc *MagicLinkCreate) Exec(ctx context.Context) error {
+	_, err := mlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mlc *MagicLinkCreate) ExecX(ctx context.Context) {
+	if err := mlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mlc *MagicLinkCreate) defaults() {
+	if _, ok := mlc.mutation.CreatedAt(); !ok {
+		v := magiclink.DefaultCreatedAt()
+		mlc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mlc *MagicLinkCreate) check() error {
+	if _, ok := mlc.mutation.Jti(); !ok {
+		return &ValidationError{Name: "jti", err: errors.New(`ent: missing required field "MagicLink.jti"`)}
+	}
+	if v, ok := mlc.mutation.Jti(); ok {
+		if err := magiclink.JtiValidator(v); err != nil {
+			return &ValidationError{Name: "jti", err: fmt.Errorf(`ent: validator failed for field "MagicLink.jti": %w`, err)}
+		}
+	}
+	if _, ok := mlc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "MagicLink.user_id"`)}
+	}
+	if _, ok := mlc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MagicLink.created_at"`)}
+	}
+	if len(mlc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "MagicLink.user"`)}
+	}
+	return nil
+}
+
+func (mlc *MagicLinkCreate) sqlSave(ctx context.Context) (*MagicLink, error) {
+	if err := mlc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mlc.mutation.id = &_node.ID
+	mlc.mutation.done = true
+	return _node, nil
+}
+
+func (mlc *MagicLinkCreate) createSpec() (*MagicLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MagicLink{config: mlc.config}
+		_spec = sqlgraph.NewCreateSpec(magiclink.Table, sqlgraph.NewFieldSpec(magiclink.FieldID, field.TypeInt))
+	)
+	if value, ok := mlc.mutation.Jti(); ok {
+		_spec.SetField(magiclink.FieldJti, field.TypeString, value)
+		_node.Jti = value
+	}
+	if value, ok := mlc.mutation.CreatedAt(); ok {
+		_spec.SetField(magiclink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mlc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   magiclink.UserTable,
+			Columns: []string{magiclink.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MagicLinkCreateBulk is the builder for creating many MagicLink entities in bulk.
+type MagicLinkCreateBulk struct {
+	config
+	err      error
+	builders []*MagicLinkCreate
+}
+
+// Save creates the MagicLink entities in the database.
+func (mlcb *MagicLinkCreateBulk) Save(ctx context.Context) ([]*MagicLink, error) {
+	if mlcb.err != nil {
+		return nil, mlcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mlcb.builders))
+	nodes := make([]*MagicLink, len(mlcb.builders))
+	mutators := make([]Mutator, len(mlcb.builders))
+	for i := range mlcb.builders {
+		func(i int, root context.Context) {
+			builder := mlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MagicLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mlcb *MagicLinkCreateBulk) SaveX(ctx context.Context) []*MagicLink {
+	v, err := mlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mlcb *MagicLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := mlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mlcb *MagicLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := mlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
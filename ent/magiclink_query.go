@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/magiclink"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/user"
+)
+
+// MagicLinkQuery is the builder for querying MagicLink entities.
+type MagicLinkQuery struct {
+	config
+	ctx        *QueryContext
+	order      []magiclink.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MagicLink
+	withUser   *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MagicLinkQuery builder.
+func (mlq *MagicLinkQuery) Where(ps ...predicate.MagicLink) *MagicLinkQuery {
+	mlq.predicates = append(mlq.predicates, ps...)
+	return mlq
+}
+
+// Limit the number of records to be returned by this query.
+func (mlq *MagicLinkQuery) Limit(limit int) *MagicLinkQuery {
+	mlq.ctx.Limit = &limit
+	return mlq
+}
+
+// Offset to start from.
+func (mlq *MagicLinkQuery) Offset(offset int) *MagicLinkQuery {
+	mlq.ctx.Offset = &offset
+	return mlq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mlq *MagicLinkQuery) Unique(unique bool) *MagicLinkQuery {
+	mlq.ctx.Unique = &unique
+	return mlq
+}
+
+// Order specifies how the records should be ordered.
+func (mlq *MagicLinkQuery) Order(o ...magiclink.OrderOption) *MagicLinkQuery {
+	mlq.order = append(mlq.order, o...)
+	return mlq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (mlq *MagicLinkQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: mlq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mlq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(magiclink.Table, magiclink.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, magiclink.UserTable, magiclink.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mlq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MagicLink entity from the query.
+// Returns a *NotFoundError when no MagicLink was found.
+func (mlq *MagicLinkQuery) First(ctx context.Context) (*MagicLink, error) {
+	nodes, err := mlq.Limit(1).All(setContextOp(ctx, mlq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{magiclink.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mlq *MagicLinkQuery) FirstX(ctx context.Context) *MagicLink {
+	node, err := mlq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MagicLink ID from the query.
+// Returns a *NotFoundError when no MagicLink ID was found.
+func (mlq *MagicLinkQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mlq.Limit(1).IDs(setContextOp(ctx, mlq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{magiclink.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mlq *MagicLinkQuery) FirstIDX(ctx context.Context) int {
+	id, err := mlq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MagicLink entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MagicLink entity is found.
+// Returns a *NotFoundError when no MagicLink entities are found.
+func (mlq *MagicLinkQuery) Only(ctx context.Context) (*MagicLink, error) {
+	nodes, err := mlq.Limit(2).All(setContextOp(ctx, mlq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{magiclink.Label}
+	default:
+		return nil, &NotSingularError{magiclink.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mlq *MagicLinkQuery) OnlyX(ctx context.Context) *MagicLink {
+	node, err := mlq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MagicLink ID in the query.
+// Returns a *NotSingularError when more than one MagicLink ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mlq *MagicLinkQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mlq.Limit(2).IDs(setContextOp(ctx, mlq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{magiclink.Label}
+	default:
+		err = &NotSingularError{magiclink.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mlq *MagicLinkQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mlq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MagicLinks.
+func (mlq *MagicLinkQuery) All(ctx context.Context) ([]*MagicLink, error) {
+	ctx = setContextOp(ctx, mlq.ctx, ent.OpQueryAll)
+	if err := mlq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MagicLink, *MagicLinkQuery]()
+	return withInterceptors[[]*MagicLink](ctx, mlq, qr, mlq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mlq *MagicLinkQuery) AllX(ctx context.Context) []*MagicLink {
+	nodes, err := mlq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MagicLink IDs.
+func (mlq *MagicLinkQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mlq.ctx.Unique == nil && mlq.path != nil {
+		mlq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mlq.ctx, ent.OpQueryIDs)
+	if err = mlq.Select(magiclink.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mlq *MagicLinkQuery) IDsX(ctx context.Context) []int {
+	ids, err := mlq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mlq *MagicLinkQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mlq.ctx, ent.OpQueryCount)
+	if err := mlq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mlq, querierCount[*MagicLinkQuery](), mlq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mlq *MagicLinkQuery) CountX(ctx context.Context) int {
+	count, err := mlq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mlq *MagicLinkQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mlq.ctx, ent.OpQueryExist)
+	switch _, err := mlq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mlq *MagicLinkQuery) ExistX(ctx context.Context) bool {
+	exist, err := mlq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MagicLinkQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mlq *MagicLinkQuery) Clone() *MagicLinkQuery {
+	if mlq == nil {
+		return nil
+	}
+	return &MagicLinkQuery{
+		config:     mlq.config,
+		ctx:        mlq.ctx.Clone(),
+		order:      append([]magiclink.OrderOption{}, mlq.order...),
+		inters:     append([]Interceptor{}, mlq.inters...),
+		predicates: append([]predicate.MagicLink{}, mlq.predicates...),
+		withUser:   mlq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  mlq.sql.Clone(),
+		path: mlq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (mlq *MagicLinkQuery) WithUser(opts ...func(*UserQuery)) *MagicLinkQuery {
+	query := (&UserClient{config: mlq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mlq.withUser = query
+	return mlq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Jti string `json:"jti,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MagicLink.Query().
+//		GroupBy(magiclink.FieldJti).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mlq *MagicLinkQuery) GroupBy(field string, fields ...string) *MagicLinkGroupBy {
+	mlq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MagicLinkGroupBy{build: mlq}
+	grbuild.flds = &mlq.ctx.Fields
+	grbuild.label = magiclink.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Jti string `json:"jti,omitempty"`
+//	}
+//
+//	client.MagicLink.Query().
+//		Select(magiclink.FieldJti).
+//		Scan(ctx, &v)
+func (mlq *MagicLinkQuery) Select(fields ...string) *MagicLinkSelect {
+	mlq.ctx.Fields = append(mlq.ctx.Fields, fields...)
+	sbuild := &MagicLinkSelect{MagicLinkQuery: mlq}
+	sbuild.label = magiclink.Label
+	sbuild.flds, sbuild.scan = &mlq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MagicLinkSelect configured with the given aggregations.
+func (mlq *MagicLinkQuery) Aggregate(fns ...AggregateFunc) *MagicLinkSelect {
+	return mlq.Select().Aggregate(fns...)
+}
+
+func (mlq *MagicLinkQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mlq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mlq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mlq.ctx.Fields {
+		if !magiclink.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mlq.path != nil {
+		prev, err := mlq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mlq.sql = prev
+	}
+	return nil
+}
+
+func (mlq *MagicLinkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MagicLink, error) {
+	var (
+		nodes       = []*MagicLink{}
+		_spec       = mlq.querySpec()
+		loadedTypes = [1]bool{
+			mlq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MagicLink).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MagicLink{config: mlq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mlq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mlq.withUser; query != nil {
+		if err := mlq.loadUser(ctx, query, nodes, nil,
+			func(n *MagicLink, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mlq *MagicLinkQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*MagicLink, init func(*MagicLink), assign func(*MagicLink, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MagicLink)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mlq *MagicLinkQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mlq.querySpec()
+	_spec.Node.Columns = mlq.ctx.Fields
+	if len(mlq.ctx.Fields) > 0 {
+		_spec.Unique = mlq.ctx.Unique != nil && *mlq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mlq.driver, _spec)
+}
+
+func (mlq *MagicLinkQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(magiclink.Table, magiclink.Columns, sqlgraph.NewFieldSpec(magiclink.FieldID, field.TypeInt))
+	_spec.From = mlq.sql
+	if unique := mlq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mlq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mlq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, magiclink.FieldID)
+		for i := range fields {
+			if fields[i] != magiclink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if mlq.withUser != nil {
+			_spec.Node.AddColumnOnce(magiclink.FieldUserID)
+		}
+	}
+	if ps := mlq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mlq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mlq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mlq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mlq *MagicLinkQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mlq.driver.Dialect())
+	t1 := builder.Table(magiclink.Table)
+	columns := mlq.ctx.Fields
+	if len(columns) == 0 {
+		columns = magiclink.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mlq.sql != nil {
+		selector = mlq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mlq.ctx.Unique != nil && *mlq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mlq.predicates {
+		p(selector)
+	}
+	for _, p := range mlq.order {
+		p(selector)
+	}
+	if offset := mlq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mlq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MagicLinkGroupBy is the group-by builder for MagicLink entities.
+type MagicLinkGroupBy struct {
+	selector
+	build *MagicLinkQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mlgb *MagicLinkGroupBy) Aggregate(fns ...AggregateFunc) *MagicLinkGroupBy {
+	mlgb.fns = append(mlgb.fns, fns...)
+	return mlgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mlgb *MagicLinkGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mlgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mlgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MagicLinkQuery, *MagicLinkGroupBy](ctx, mlgb.build, mlgb, mlgb.build.inters, v)
+}
+
+func (mlgb *MagicLinkGroupBy) sqlScan(ctx context.Context, root *MagicLinkQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mlgb.fns))
+	for _, fn := range mlgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mlgb.flds)+len(mlgb.fns))
+		for _, f := range *mlgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mlgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mlgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MagicLinkSelect is the builder for selecting fields of MagicLink entities.
+type MagicLinkSelect struct {
+	*MagicLinkQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mls *MagicLinkSelect) Aggregate(fns ...AggregateFunc) *MagicLinkSelect {
+	mls.fns = append(mls.fns, fns...)
+	return mls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mls *MagicLinkSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mls.ctx, ent.OpQuerySelect)
+	if err := mls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MagicLinkQuery, *MagicLinkSelect](ctx, mls.MagicLinkQuery, mls, mls.inters, v)
+}
+
+func (mls *MagicLinkSelect) sqlScan(ctx context.Context, root *MagicLinkQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mls.fns))
+	for _, fn := range mls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/magiclink"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// MagicLinkUpdate is the builder for updating MagicLink entities.
+type MagicLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MagicLinkMutation
+}
+
+// Where appends a list predicates to the MagicLinkUpdate builder.
+func (mlu *MagicLinkUpdate) Where(ps ...predicate.MagicLink) *MagicLinkUpdate {
+	mlu.mutation.Where(ps...)
+	return mlu
+}
+
+// Mutation returns the MagicLinkMutation object of the builder.
+func (mlu *MagicLinkUpdate) Mutation() *MagicLinkMutation {
+	return mlu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mlu *MagicLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mlu.sqlSave, mlu.mutation, mlu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mlu *MagicLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := mlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mlu *MagicLinkUpdate) Exec(ctx context.Context) error {
+	_, err := mlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mlu *MagicLinkUpdate) ExecX(ctx context.Context) {
+	if err := mlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mlu *MagicLinkUpdate) check() error {
+	if mlu.mutation.UserCleared() && len(mlu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MagicLink.user"`)
+	}
+	return nil
+}
+
+func (mlu *MagicLinkUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mlu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(magiclink.Table, magiclink.Columns, sqlgraph.NewFieldSpec(magiclink.FieldID, field.TypeInt))
+	if ps := mlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{magiclink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mlu.mutation.done = true
+	return n, nil
+}
+
+// MagicLinkUpdateOne is the builder for updating a single MagicLink entity.
+type MagicLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MagicLinkMutation
+}
+
+// Mutation returns the MagicLinkMutation object of the builder.
+func (mluo *MagicLinkUpdateOne) Mutation() *MagicLinkMutation {
+	return mluo.mutation
+}
+
+// Where appends a list predicates to the MagicLinkUpdate builder.
+func (mluo *MagicLinkUpdateOne) Where(ps ...predicate.MagicLink) *MagicLinkUpdateOne {
+	mluo.mutation.Where(ps...)
+	return mluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mluo *MagicLinkUpdateOne) Select(field string, fields ...string) *MagicLinkUpdateOne {
+	mluo.fields = append([]string{field}, fields...)
+	return mluo
+}
+
+// Save executes the query and returns the updated MagicLink entity.
+func (mluo *MagicLinkUpdateOne) Save(ctx context.Context) (*MagicLink, error) {
+	return withHooks(ctx, mluo.sqlSave, mluo.mutation, mluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mluo *MagicLinkUpdateOne) SaveX(ctx context.Context) *MagicLink {
+	node, err := mluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mluo *MagicLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := mluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mluo *MagicLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := mluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mluo *MagicLinkUpdateOne) check() error {
+	if mluo.mutation.UserCleared() && len(mluo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MagicLink.user"`)
+	}
+	return nil
+}
+
+func (mluo *MagicLinkUpdateOne) sqlSave(ctx context.Context) (_node *MagicLink, err error) {
+	if err := mluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(magiclink.Table, magiclink.Columns, sqlgraph.NewFieldSpec(magiclink.FieldID, field.TypeInt))
+	id, ok := mluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MagicLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, magiclink.FieldID)
+		for _, f := range fields {
+			if !magiclink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != magiclink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &MagicLink{config: mluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{magiclink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mluo.mutation.done = true
+	return _node, nil
+}
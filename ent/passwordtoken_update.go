@@ -13,7 +13,6 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/mikestefanello/pagoda/ent/passwordtoken"
 	"github.com/mikestefanello/pagoda/ent/predicate"
-	"github.com/mikestefanello/pagoda/ent/user"
 )
 
 // PasswordTokenUpdate is the builder for updating PasswordToken entities.
@@ -43,20 +42,6 @@ func (ptu *PasswordTokenUpdate) SetNillableToken(s *string) *PasswordTokenUpdate
 	return ptu
 }
 
-// SetUserID sets the "user_id" field.
-func (ptu *PasswordTokenUpdate) SetUserID(i int) *PasswordTokenUpdate {
-	ptu.mutation.SetUserID(i)
-	return ptu
-}
-
-// SetNillableUserID sets the "user_id" field if the given value is not nil.
-func (ptu *PasswordTokenUpdate) SetNillableUserID(i *int) *PasswordTokenUpdate {
-	if i != nil {
-		ptu.SetUserID(*i)
-	}
-	return ptu
-}
-
 // SetCreatedAt sets the "created_at" field.
 func (ptu *PasswordTokenUpdate) SetCreatedAt(t time.Time) *PasswordTokenUpdate {
 	ptu.mutation.SetCreatedAt(t)
@@ -71,22 +56,11 @@ func (ptu *PasswordTokenUpdate) SetNillableCreatedAt(t *time.Time) *PasswordToke
 	return ptu
 }
 
-// SetUser sets the "user" edge to the User entity.
-func (ptu *PasswordTokenUpdate) SetUser(u *User) *PasswordTokenUpdate {
-	return ptu.SetUserID(u.ID)
-}
-
 // Mutation returns the PasswordTokenMutation object of the builder.
 func (ptu *PasswordTokenUpdate) Mutation() *PasswordTokenMutation {
 	return ptu.mutation
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (ptu *PasswordTokenUpdate) ClearUser() *PasswordTokenUpdate {
-	ptu.mutation.ClearUser()
-	return ptu
-}
-
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ptu *PasswordTokenUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, ptu.sqlSave, ptu.mutation, ptu.hooks)
@@ -145,35 +119,6 @@ func (ptu *PasswordTokenUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := ptu.mutation.CreatedAt(); ok {
 		_spec.SetField(passwordtoken.FieldCreatedAt, field.TypeTime, value)
 	}
-	if ptu.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: false,
-			Table:   passwordtoken.UserTable,
-			Columns: []string{passwordtoken.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ptu.mutation.UserIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: false,
-			Table:   passwordtoken.UserTable,
-			Columns: []string{passwordtoken.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
-	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ptu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{passwordtoken.Label}
@@ -208,20 +153,6 @@ func (ptuo *PasswordTokenUpdateOne) SetNillableToken(s *string) *PasswordTokenUp
 	return ptuo
 }
 
-// SetUserID sets the "user_id" field.
-func (ptuo *PasswordTokenUpdateOne) SetUserID(i int) *PasswordTokenUpdateOne {
-	ptuo.mutation.SetUserID(i)
-	return ptuo
-}
-
-// SetNillableUserID sets the "user_id" field if the given value is not nil.
-func (ptuo *PasswordTokenUpdateOne) SetNillableUserID(i *int) *PasswordTokenUpdateOne {
-	if i != nil {
-		ptuo.SetUserID(*i)
-	}
-	return ptuo
-}
-
 // SetCreatedAt sets the "created_at" field.
 func (ptuo *PasswordTokenUpdateOne) SetCreatedAt(t time.Time) *PasswordTokenUpdateOne {
 	ptuo.mutation.SetCreatedAt(t)
@@ -236,22 +167,11 @@ func (ptuo *PasswordTokenUpdateOne) SetNillableCreatedAt(t *time.Time) *Password
 	return ptuo
 }
 
-// SetUser sets the "user" edge to the User entity.
-func (ptuo *PasswordTokenUpdateOne) SetUser(u *User) *PasswordTokenUpdateOne {
-	return ptuo.SetUserID(u.ID)
-}
-
 // Mutation returns the PasswordTokenMutation object of the builder.
 func (ptuo *PasswordTokenUpdateOne) Mutation() *PasswordTokenMutation {
 	return ptuo.mutation
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (ptuo *PasswordTokenUpdateOne) ClearUser() *PasswordTokenUpdateOne {
-	ptuo.mutation.ClearUser()
-	return ptuo
-}
-
 // Where appends a list predicates to the PasswordTokenUpdate builder.
 func (ptuo *PasswordTokenUpdateOne) Where(ps ...predicate.PasswordToken) *PasswordTokenUpdateOne {
 	ptuo.mutation.Where(ps...)
@@ -340,35 +260,6 @@ func (ptuo *PasswordTokenUpdateOne) sqlSave(ctx context.Context) (_node *Passwor
 	if value, ok := ptuo.mutation.CreatedAt(); ok {
 		_spec.SetField(passwordtoken.FieldCreatedAt, field.TypeTime, value)
 	}
-	if ptuo.mutation.UserCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: false,
-			Table:   passwordtoken.UserTable,
-			Columns: []string{passwordtoken.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := ptuo.mutation.UserIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: false,
-			Table:   passwordtoken.UserTable,
-			Columns: []string{passwordtoken.UserColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
-	}
 	_node = &PasswordToken{config: ptuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/remembertoken"
+	"github.com/mikestefanello/pagoda/ent/user"
+)
+
+// RememberToken is the model entity for the RememberToken schema.
+type RememberToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Series holds the value of the "series" field.
+	Series string `json:"series,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"-"`
+	// PreviousToken holds the value of the "previous_token" field.
+	PreviousToken string `json:"-"`
+	// SessionToken holds the value of the "session_token" field.
+	SessionToken string `json:"-"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// RotatedAt holds the value of the "rotated_at" field.
+	RotatedAt time.Time `json:"rotated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RememberTokenQuery when eager-loading is set.
+	Edges        RememberTokenEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RememberTokenEdges holds the relations/edges for other nodes in the graph.
+type RememberTokenEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RememberTokenEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RememberToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case remembertoken.FieldID, remembertoken.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case remembertoken.FieldSeries, remembertoken.FieldToken, remembertoken.FieldPreviousToken, remembertoken.FieldSessionToken:
+			values[i] = new(sql.NullString)
+		case remembertoken.FieldCreatedAt, remembertoken.FieldRotatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RememberToken fields.
+func (rt *RememberToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case remembertoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rt.ID = int(value.Int64)
+		case remembertoken.FieldSeries:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field series", values[i])
+			} else if value.Valid {
+				rt.Series = value.String
+			}
+		case remembertoken.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				rt.Token = value.String
+			}
+		case remembertoken.FieldPreviousToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_token", values[i])
+			} else if value.Valid {
+				rt.PreviousToken = value.String
+			}
+		case remembertoken.FieldSessionToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_token", values[i])
+			} else if value.Valid {
+				rt.SessionToken = value.String
+			}
+		case remembertoken.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				rt.UserID = int(value.Int64)
+			}
+		case remembertoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rt.CreatedAt = value.Time
+			}
+		case remembertoken.FieldRotatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rotated_at", values[i])
+			} else if value.Valid {
+				rt.RotatedAt = value.Time
+			}
+		default:
+			rt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RememberToken.
+// This includes values selected through modifiers, order, etc.
+func (rt *RememberToken) Value(name string) (ent.Value, error) {
+	return rt.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the RememberToken entity.
+func (rt *RememberToken) QueryUser() *UserQuery {
+	return NewRememberTokenClient(rt.config).QueryUser(rt)
+}
+
+// Update returns a builder for updating this RememberToken.
+// Note that you need to call RememberToken.Unwrap() before calling this method if this RememberToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rt *RememberToken) Update() *RememberTokenUpdateOne {
+	return NewRememberTokenClient(rt.config).UpdateOne(rt)
+}
+
+// Unwrap unwraps the RememberToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rt *RememberToken) Unwrap() *RememberToken {
+	_tx, ok := rt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RememberToken is not a transactional entity")
+	}
+	rt.config.driver = _tx.drv
+	return rt
+}
+
+// String implements the fmt.Stringer.
+func (rt *RememberToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("RememberToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rt.ID))
+	builder.WriteString("series=")
+	builder.WriteString(rt.Series)
+	builder.WriteString(", ")
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("previous_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("session_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", rt.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("rotated_at=")
+	builder.WriteString(rt.RotatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RememberTokens is a parsable slice of RememberToken.
+type RememberTokens []*RememberToken
@@ -0,0 +1,519 @@
+// Code generated by ent, DO NOT EDIT.
+
+package remembertoken
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldLTE(FieldID, id))
+}
+
+// Series applies equality check predicate on the "series" field. It's identical to SeriesEQ.
+func Series(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEQ(FieldSeries, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEQ(FieldToken, v))
+}
+
+// PreviousToken applies equality check predicate on the "previous_token" field. It's identical to PreviousTokenEQ.
+func PreviousToken(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEQ(FieldPreviousToken, v))
+}
+
+// SessionToken applies equality check predicate on the "session_token" field. It's identical to SessionTokenEQ.
+func SessionToken(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEQ(FieldSessionToken, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEQ(FieldUserID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RotatedAt applies equality check predicate on the "rotated_at" field. It's identical to RotatedAtEQ.
+func RotatedAt(v time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEQ(FieldRotatedAt, v))
+}
+
+// SeriesEQ applies the EQ predicate on the "series" field.
+func SeriesEQ(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEQ(FieldSeries, v))
+}
+
+// SeriesNEQ applies the NEQ predicate on the "series" field.
+func SeriesNEQ(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNEQ(FieldSeries, v))
+}
+
+// SeriesIn applies the In predicate on the "series" field.
+func SeriesIn(vs ...string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldIn(FieldSeries, vs...))
+}
+
+// SeriesNotIn applies the NotIn predicate on the "series" field.
+func SeriesNotIn(vs ...string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNotIn(FieldSeries, vs...))
+}
+
+// SeriesGT applies the GT predicate on the "series" field.
+func SeriesGT(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldGT(FieldSeries, v))
+}
+
+// SeriesGTE applies the GTE predicate on the "series" field.
+func SeriesGTE(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldGTE(FieldSeries, v))
+}
+
+// SeriesLT applies the LT predicate on the "series" field.
+func SeriesLT(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldLT(FieldSeries, v))
+}
+
+// SeriesLTE applies the LTE predicate on the "series" field.
+func SeriesLTE(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldLTE(FieldSeries, v))
+}
+
+// SeriesContains applies the Contains predicate on the "series" field.
+func SeriesContains(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldContains(FieldSeries, v))
+}
+
+// SeriesHasPrefix applies the HasPrefix predicate on the "series" field.
+func SeriesHasPrefix(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldHasPrefix(FieldSeries, v))
+}
+
+// SeriesHasSuffix applies the HasSuffix predicate on the "series" field.
+func SeriesHasSuffix(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldHasSuffix(FieldSeries, v))
+}
+
+// SeriesEqualFold applies the EqualFold predicate on the "series" field.
+func SeriesEqualFold(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEqualFold(FieldSeries, v))
+}
+
+// SeriesContainsFold applies the ContainsFold predicate on the "series" field.
+func SeriesContainsFold(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldContainsFold(FieldSeries, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldContainsFold(FieldToken, v))
+}
+
+// PreviousTokenEQ applies the EQ predicate on the "previous_token" field.
+func PreviousTokenEQ(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEQ(FieldPreviousToken, v))
+}
+
+// PreviousTokenNEQ applies the NEQ predicate on the "previous_token" field.
+func PreviousTokenNEQ(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNEQ(FieldPreviousToken, v))
+}
+
+// PreviousTokenIn applies the In predicate on the "previous_token" field.
+func PreviousTokenIn(vs ...string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldIn(FieldPreviousToken, vs...))
+}
+
+// PreviousTokenNotIn applies the NotIn predicate on the "previous_token" field.
+func PreviousTokenNotIn(vs ...string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNotIn(FieldPreviousToken, vs...))
+}
+
+// PreviousTokenGT applies the GT predicate on the "previous_token" field.
+func PreviousTokenGT(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldGT(FieldPreviousToken, v))
+}
+
+// PreviousTokenGTE applies the GTE predicate on the "previous_token" field.
+func PreviousTokenGTE(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldGTE(FieldPreviousToken, v))
+}
+
+// PreviousTokenLT applies the LT predicate on the "previous_token" field.
+func PreviousTokenLT(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldLT(FieldPreviousToken, v))
+}
+
+// PreviousTokenLTE applies the LTE predicate on the "previous_token" field.
+func PreviousTokenLTE(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldLTE(FieldPreviousToken, v))
+}
+
+// PreviousTokenContains applies the Contains predicate on the "previous_token" field.
+func PreviousTokenContains(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldContains(FieldPreviousToken, v))
+}
+
+// PreviousTokenHasPrefix applies the HasPrefix predicate on the "previous_token" field.
+func PreviousTokenHasPrefix(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldHasPrefix(FieldPreviousToken, v))
+}
+
+// PreviousTokenHasSuffix applies the HasSuffix predicate on the "previous_token" field.
+func PreviousTokenHasSuffix(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldHasSuffix(FieldPreviousToken, v))
+}
+
+// PreviousTokenIsNil applies the IsNil predicate on the "previous_token" field.
+func PreviousTokenIsNil() predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldIsNull(FieldPreviousToken))
+}
+
+// PreviousTokenNotNil applies the NotNil predicate on the "previous_token" field.
+func PreviousTokenNotNil() predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNotNull(FieldPreviousToken))
+}
+
+// PreviousTokenEqualFold applies the EqualFold predicate on the "previous_token" field.
+func PreviousTokenEqualFold(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEqualFold(FieldPreviousToken, v))
+}
+
+// PreviousTokenContainsFold applies the ContainsFold predicate on the "previous_token" field.
+func PreviousTokenContainsFold(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldContainsFold(FieldPreviousToken, v))
+}
+
+// SessionTokenEQ applies the EQ predicate on the "session_token" field.
+func SessionTokenEQ(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEQ(FieldSessionToken, v))
+}
+
+// SessionTokenNEQ applies the NEQ predicate on the "session_token" field.
+func SessionTokenNEQ(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNEQ(FieldSessionToken, v))
+}
+
+// SessionTokenIn applies the In predicate on the "session_token" field.
+func SessionTokenIn(vs ...string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldIn(FieldSessionToken, vs...))
+}
+
+// SessionTokenNotIn applies the NotIn predicate on the "session_token" field.
+func SessionTokenNotIn(vs ...string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNotIn(FieldSessionToken, vs...))
+}
+
+// SessionTokenGT applies the GT predicate on the "session_token" field.
+func SessionTokenGT(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldGT(FieldSessionToken, v))
+}
+
+// SessionTokenGTE applies the GTE predicate on the "session_token" field.
+func SessionTokenGTE(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldGTE(FieldSessionToken, v))
+}
+
+// SessionTokenLT applies the LT predicate on the "session_token" field.
+func SessionTokenLT(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldLT(FieldSessionToken, v))
+}
+
+// SessionTokenLTE applies the LTE predicate on the "session_token" field.
+func SessionTokenLTE(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldLTE(FieldSessionToken, v))
+}
+
+// SessionTokenContains applies the Contains predicate on the "session_token" field.
+func SessionTokenContains(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldContains(FieldSessionToken, v))
+}
+
+// SessionTokenHasPrefix applies the HasPrefix predicate on the "session_token" field.
+func SessionTokenHasPrefix(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldHasPrefix(FieldSessionToken, v))
+}
+
+// SessionTokenHasSuffix applies the HasSuffix predicate on the "session_token" field.
+func SessionTokenHasSuffix(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldHasSuffix(FieldSessionToken, v))
+}
+
+// SessionTokenIsNil applies the IsNil predicate on the "session_token" field.
+func SessionTokenIsNil() predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldIsNull(FieldSessionToken))
+}
+
+// SessionTokenNotNil applies the NotNil predicate on the "session_token" field.
+func SessionTokenNotNil() predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNotNull(FieldSessionToken))
+}
+
+// SessionTokenEqualFold applies the EqualFold predicate on the "session_token" field.
+func SessionTokenEqualFold(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEqualFold(FieldSessionToken, v))
+}
+
+// SessionTokenContainsFold applies the ContainsFold predicate on the "session_token" field.
+func SessionTokenContainsFold(v string) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldContainsFold(FieldSessionToken, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// RotatedAtEQ applies the EQ predicate on the "rotated_at" field.
+func RotatedAtEQ(v time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldEQ(FieldRotatedAt, v))
+}
+
+// RotatedAtNEQ applies the NEQ predicate on the "rotated_at" field.
+func RotatedAtNEQ(v time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNEQ(FieldRotatedAt, v))
+}
+
+// RotatedAtIn applies the In predicate on the "rotated_at" field.
+func RotatedAtIn(vs ...time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldIn(FieldRotatedAt, vs...))
+}
+
+// RotatedAtNotIn applies the NotIn predicate on the "rotated_at" field.
+func RotatedAtNotIn(vs ...time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNotIn(FieldRotatedAt, vs...))
+}
+
+// RotatedAtGT applies the GT predicate on the "rotated_at" field.
+func RotatedAtGT(v time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldGT(FieldRotatedAt, v))
+}
+
+// RotatedAtGTE applies the GTE predicate on the "rotated_at" field.
+func RotatedAtGTE(v time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldGTE(FieldRotatedAt, v))
+}
+
+// RotatedAtLT applies the LT predicate on the "rotated_at" field.
+func RotatedAtLT(v time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldLT(FieldRotatedAt, v))
+}
+
+// RotatedAtLTE applies the LTE predicate on the "rotated_at" field.
+func RotatedAtLTE(v time.Time) predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldLTE(FieldRotatedAt, v))
+}
+
+// RotatedAtIsNil applies the IsNil predicate on the "rotated_at" field.
+func RotatedAtIsNil() predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldIsNull(FieldRotatedAt))
+}
+
+// RotatedAtNotNil applies the NotNil predicate on the "rotated_at" field.
+func RotatedAtNotNil() predicate.RememberToken {
+	return predicate.RememberToken(sql.FieldNotNull(FieldRotatedAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.RememberToken {
+	return predicate.RememberToken(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.RememberToken {
+	return predicate.RememberToken(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RememberToken) predicate.RememberToken {
+	return predicate.RememberToken(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RememberToken) predicate.RememberToken {
+	return predicate.RememberToken(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RememberToken) predicate.RememberToken {
+	return predicate.RememberToken(sql.NotPredicates(p))
+}
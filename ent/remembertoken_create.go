@@ -0,0 +1,333 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/remembertoken"
+	"github.com/mikestefanello/pagoda/ent/user"
+)
+
+// RememberTokenCreate is the builder for creating a RememberToken entity.
+type RememberTokenCreate struct {
+	config
+	mutation *RememberTokenMutation
+	hooks    []Hook
+}
+
+// SetSeries sets the "series" field.
+func (rtc *RememberTokenCreate) SetSeries(s string) *RememberTokenCreate {
+	rtc.mutation.SetSeries(s)
+	return rtc
+}
+
+// SetToken sets the "token" field.
+func (rtc *RememberTokenCreate) SetToken(s string) *RememberTokenCreate {
+	rtc.mutation.SetToken(s)
+	return rtc
+}
+
+// SetPreviousToken sets the "previous_token" field.
+func (rtc *RememberTokenCreate) SetPreviousToken(s string) *RememberTokenCreate {
+	rtc.mutation.SetPreviousToken(s)
+	return rtc
+}
+
+// SetNillablePreviousToken sets the "previous_token" field if the given value is not nil.
+func (rtc *RememberTokenCreate) SetNillablePreviousToken(s *string) *RememberTokenCreate {
+	if s != nil {
+		rtc.SetPreviousToken(*s)
+	}
+	return rtc
+}
+
+// SetSessionToken sets the "session_token" field.
+func (rtc *RememberTokenCreate) SetSessionToken(s string) *RememberTokenCreate {
+	rtc.mutation.SetSessionToken(s)
+	return rtc
+}
+
+// SetNillableSessionToken sets the "session_token" field if the given value is not nil.
+func (rtc *RememberTokenCreate) SetNillableSessionToken(s *string) *RememberTokenCreate {
+	if s != nil {
+		rtc.SetSessionToken(*s)
+	}
+	return rtc
+}
+
+// SetUserID sets the "user_id" field.
+func (rtc *RememberTokenCreate) SetUserID(i int) *RememberTokenCreate {
+	rtc.mutation.SetUserID(i)
+	return rtc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rtc *RememberTokenCreate) SetCreatedAt(t time.Time) *RememberTokenCreate {
+	rtc.mutation.SetCreatedAt(t)
+	return rtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rtc *RememberTokenCreate) SetNillableCreatedAt(t *time.Time) *RememberTokenCreate {
+	if t != nil {
+		rtc.SetCreatedAt(*t)
+	}
+	return rtc
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (rtc *RememberTokenCreate) SetRotatedAt(t time.Time) *RememberTokenCreate {
+	rtc.mutation.SetRotatedAt(t)
+	return rtc
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (rtc *RememberTokenCreate) SetNillableRotatedAt(t *time.Time) *RememberTokenCreate {
+	if t != nil {
+		rtc.SetRotatedAt(*t)
+	}
+	return rtc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rtc *RememberTokenCreate) SetUser(u *User) *RememberTokenCreate {
+	return rtc.SetUserID(u.ID)
+}
+
+// Mutation returns the RememberTokenMutation object of the builder.
+func (rtc *RememberTokenCreate) Mutation() *RememberTokenMutation {
+	return rtc.mutation
+}
+
+// Save creates the RememberToken in the database.
+func (rtc *RememberTokenCreate) Save(ctx context.Context) (*RememberToken, error) {
+	if err := rtc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rtc.sqlSave, rtc.mutation, rtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rtc *RememberTokenCreate) SaveX(ctx context.Context) *RememberToken {
+	v, err := rtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtc *RememberTokenCreate) Exec(ctx context.Context) error {
+	_, err := rtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtc *RememberTokenCreate) ExecX(ctx context.Context) {
+	if err := rtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtc *RememberTokenCreate) defaults() error {
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		if remembertoken.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized remembertoken.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := remembertoken.DefaultCreatedAt()
+		rtc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtc *RememberTokenCreate) check() error {
+	if _, ok := rtc.mutation.Series(); !ok {
+		return &ValidationError{Name: "series", err: errors.New(`ent: missing required field "RememberToken.series"`)}
+	}
+	if v, ok := rtc.mutation.Series(); ok {
+		if err := remembertoken.SeriesValidator(v); err != nil {
+			return &ValidationError{Name: "series", err: fmt.Errorf(`ent: validator failed for field "RememberToken.series": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "RememberToken.token"`)}
+	}
+	if v, ok := rtc.mutation.Token(); ok {
+		if err := remembertoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "RememberToken.token": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RememberToken.user_id"`)}
+	}
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RememberToken.created_at"`)}
+	}
+	if len(rtc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "RememberToken.user"`)}
+	}
+	return nil
+}
+
+func (rtc *RememberTokenCreate) sqlSave(ctx context.Context) (*RememberToken, error) {
+	if err := rtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rtc.mutation.id = &_node.ID
+	rtc.mutation.done = true
+	return _node, nil
+}
+
+func (rtc *RememberTokenCreate) createSpec() (*RememberToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RememberToken{config: rtc.config}
+		_spec = sqlgraph.NewCreateSpec(remembertoken.Table, sqlgraph.NewFieldSpec(remembertoken.FieldID, field.TypeInt))
+	)
+	if value, ok := rtc.mutation.Series(); ok {
+		_spec.SetField(remembertoken.FieldSeries, field.TypeString, value)
+		_node.Series = value
+	}
+	if value, ok := rtc.mutation.Token(); ok {
+		_spec.SetField(remembertoken.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := rtc.mutation.PreviousToken(); ok {
+		_spec.SetField(remembertoken.FieldPreviousToken, field.TypeString, value)
+		_node.PreviousToken = value
+	}
+	if value, ok := rtc.mutation.SessionToken(); ok {
+		_spec.SetField(remembertoken.FieldSessionToken, field.TypeString, value)
+		_node.SessionToken = value
+	}
+	if value, ok := rtc.mutation.CreatedAt(); ok {
+		_spec.SetField(remembertoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rtc.mutation.RotatedAt(); ok {
+		_spec.SetField(remembertoken.FieldRotatedAt, field.TypeTime, value)
+		_node.RotatedAt = value
+	}
+	if nodes := rtc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   remembertoken.UserTable,
+			Columns: []string{remembertoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RememberTokenCreateBulk is the builder for creating many RememberToken entities in bulk.
+type RememberTokenCreateBulk struct {
+	config
+	err      error
+	builders []*RememberTokenCreate
+}
+
+// Save creates the RememberToken entities in the database.
+func (rtcb *RememberTokenCreateBulk) Save(ctx context.Context) ([]*RememberToken, error) {
+	if rtcb.err != nil {
+		return nil, rtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rtcb.builders))
+	nodes := make([]*RememberToken, len(rtcb.builders))
+	mutators := make([]Mutator, len(rtcb.builders))
+	for i := range rtcb.builders {
+		func(i int, root context.Context) {
+			builder := rtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RememberTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtcb *RememberTokenCreateBulk) SaveX(ctx context.Context) []*RememberToken {
+	v, err := rtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtcb *RememberTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := rtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtcb *RememberTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := rtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,398 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/remembertoken"
+)
+
+// RememberTokenUpdate is the builder for updating RememberToken entities.
+type RememberTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RememberTokenMutation
+}
+
+// Where appends a list predicates to the RememberTokenUpdate builder.
+func (rtu *RememberTokenUpdate) Where(ps ...predicate.RememberToken) *RememberTokenUpdate {
+	rtu.mutation.Where(ps...)
+	return rtu
+}
+
+// SetToken sets the "token" field.
+func (rtu *RememberTokenUpdate) SetToken(s string) *RememberTokenUpdate {
+	rtu.mutation.SetToken(s)
+	return rtu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (rtu *RememberTokenUpdate) SetNillableToken(s *string) *RememberTokenUpdate {
+	if s != nil {
+		rtu.SetToken(*s)
+	}
+	return rtu
+}
+
+// SetPreviousToken sets the "previous_token" field.
+func (rtu *RememberTokenUpdate) SetPreviousToken(s string) *RememberTokenUpdate {
+	rtu.mutation.SetPreviousToken(s)
+	return rtu
+}
+
+// SetNillablePreviousToken sets the "previous_token" field if the given value is not nil.
+func (rtu *RememberTokenUpdate) SetNillablePreviousToken(s *string) *RememberTokenUpdate {
+	if s != nil {
+		rtu.SetPreviousToken(*s)
+	}
+	return rtu
+}
+
+// ClearPreviousToken clears the value of the "previous_token" field.
+func (rtu *RememberTokenUpdate) ClearPreviousToken() *RememberTokenUpdate {
+	rtu.mutation.ClearPreviousToken()
+	return rtu
+}
+
+// SetSessionToken sets the "session_token" field.
+func (rtu *RememberTokenUpdate) SetSessionToken(s string) *RememberTokenUpdate {
+	rtu.mutation.SetSessionToken(s)
+	return rtu
+}
+
+// SetNillableSessionToken sets the "session_token" field if the given value is not nil.
+func (rtu *RememberTokenUpdate) SetNillableSessionToken(s *string) *RememberTokenUpdate {
+	if s != nil {
+		rtu.SetSessionToken(*s)
+	}
+	return rtu
+}
+
+// ClearSessionToken clears the value of the "session_token" field.
+func (rtu *RememberTokenUpdate) ClearSessionToken() *RememberTokenUpdate {
+	rtu.mutation.ClearSessionToken()
+	return rtu
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (rtu *RememberTokenUpdate) SetRotatedAt(t time.Time) *RememberTokenUpdate {
+	rtu.mutation.SetRotatedAt(t)
+	return rtu
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (rtu *RememberTokenUpdate) SetNillableRotatedAt(t *time.Time) *RememberTokenUpdate {
+	if t != nil {
+		rtu.SetRotatedAt(*t)
+	}
+	return rtu
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (rtu *RememberTokenUpdate) ClearRotatedAt() *RememberTokenUpdate {
+	rtu.mutation.ClearRotatedAt()
+	return rtu
+}
+
+// Mutation returns the RememberTokenMutation object of the builder.
+func (rtu *RememberTokenUpdate) Mutation() *RememberTokenMutation {
+	return rtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rtu *RememberTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rtu.sqlSave, rtu.mutation, rtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtu *RememberTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := rtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rtu *RememberTokenUpdate) Exec(ctx context.Context) error {
+	_, err := rtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtu *RememberTokenUpdate) ExecX(ctx context.Context) {
+	if err := rtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtu *RememberTokenUpdate) check() error {
+	if v, ok := rtu.mutation.Token(); ok {
+		if err := remembertoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "RememberToken.token": %w`, err)}
+		}
+	}
+	if rtu.mutation.UserCleared() && len(rtu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RememberToken.user"`)
+	}
+	return nil
+}
+
+func (rtu *RememberTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(remembertoken.Table, remembertoken.Columns, sqlgraph.NewFieldSpec(remembertoken.FieldID, field.TypeInt))
+	if ps := rtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtu.mutation.Token(); ok {
+		_spec.SetField(remembertoken.FieldToken, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.PreviousToken(); ok {
+		_spec.SetField(remembertoken.FieldPreviousToken, field.TypeString, value)
+	}
+	if rtu.mutation.PreviousTokenCleared() {
+		_spec.ClearField(remembertoken.FieldPreviousToken, field.TypeString)
+	}
+	if value, ok := rtu.mutation.SessionToken(); ok {
+		_spec.SetField(remembertoken.FieldSessionToken, field.TypeString, value)
+	}
+	if rtu.mutation.SessionTokenCleared() {
+		_spec.ClearField(remembertoken.FieldSessionToken, field.TypeString)
+	}
+	if value, ok := rtu.mutation.RotatedAt(); ok {
+		_spec.SetField(remembertoken.FieldRotatedAt, field.TypeTime, value)
+	}
+	if rtu.mutation.RotatedAtCleared() {
+		_spec.ClearField(remembertoken.FieldRotatedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{remembertoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rtu.mutation.done = true
+	return n, nil
+}
+
+// RememberTokenUpdateOne is the builder for updating a single RememberToken entity.
+type RememberTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RememberTokenMutation
+}
+
+// SetToken sets the "token" field.
+func (rtuo *RememberTokenUpdateOne) SetToken(s string) *RememberTokenUpdateOne {
+	rtuo.mutation.SetToken(s)
+	return rtuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (rtuo *RememberTokenUpdateOne) SetNillableToken(s *string) *RememberTokenUpdateOne {
+	if s != nil {
+		rtuo.SetToken(*s)
+	}
+	return rtuo
+}
+
+// SetPreviousToken sets the "previous_token" field.
+func (rtuo *RememberTokenUpdateOne) SetPreviousToken(s string) *RememberTokenUpdateOne {
+	rtuo.mutation.SetPreviousToken(s)
+	return rtuo
+}
+
+// SetNillablePreviousToken sets the "previous_token" field if the given value is not nil.
+func (rtuo *RememberTokenUpdateOne) SetNillablePreviousToken(s *string) *RememberTokenUpdateOne {
+	if s != nil {
+		rtuo.SetPreviousToken(*s)
+	}
+	return rtuo
+}
+
+// ClearPreviousToken clears the value of the "previous_token" field.
+func (rtuo *RememberTokenUpdateOne) ClearPreviousToken() *RememberTokenUpdateOne {
+	rtuo.mutation.ClearPreviousToken()
+	return rtuo
+}
+
+// SetSessionToken sets the "session_token" field.
+func (rtuo *RememberTokenUpdateOne) SetSessionToken(s string) *RememberTokenUpdateOne {
+	rtuo.mutation.SetSessionToken(s)
+	return rtuo
+}
+
+// SetNillableSessionToken sets the "session_token" field if the given value is not nil.
+func (rtuo *RememberTokenUpdateOne) SetNillableSessionToken(s *string) *RememberTokenUpdateOne {
+	if s != nil {
+		rtuo.SetSessionToken(*s)
+	}
+	return rtuo
+}
+
+// ClearSessionToken clears the value of the "session_token" field.
+func (rtuo *RememberTokenUpdateOne) ClearSessionToken() *RememberTokenUpdateOne {
+	rtuo.mutation.ClearSessionToken()
+	return rtuo
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (rtuo *RememberTokenUpdateOne) SetRotatedAt(t time.Time) *RememberTokenUpdateOne {
+	rtuo.mutation.SetRotatedAt(t)
+	return rtuo
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (rtuo *RememberTokenUpdateOne) SetNillableRotatedAt(t *time.Time) *RememberTokenUpdateOne {
+	if t != nil {
+		rtuo.SetRotatedAt(*t)
+	}
+	return rtuo
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (rtuo *RememberTokenUpdateOne) ClearRotatedAt() *RememberTokenUpdateOne {
+	rtuo.mutation.ClearRotatedAt()
+	return rtuo
+}
+
+// Mutation returns the RememberTokenMutation object of the builder.
+func (rtuo *RememberTokenUpdateOne) Mutation() *RememberTokenMutation {
+	return rtuo.mutation
+}
+
+// Where appends a list predicates to the RememberTokenUpdate builder.
+func (rtuo *RememberTokenUpdateOne) Where(ps ...predicate.RememberToken) *RememberTokenUpdateOne {
+	rtuo.mutation.Where(ps...)
+	return rtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rtuo *RememberTokenUpdateOne) Select(field string, fields ...string) *RememberTokenUpdateOne {
+	rtuo.fields = append([]string{field}, fields...)
+	return rtuo
+}
+
+// Save executes the query and returns the updated RememberToken entity.
+func (rtuo *RememberTokenUpdateOne) Save(ctx context.Context) (*RememberToken, error) {
+	return withHooks(ctx, rtuo.sqlSave, rtuo.mutation, rtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtuo *RememberTokenUpdateOne) SaveX(ctx context.Context) *RememberToken {
+	node, err := rtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rtuo *RememberTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := rtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtuo *RememberTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := rtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtuo *RememberTokenUpdateOne) check() error {
+	if v, ok := rtuo.mutation.Token(); ok {
+		if err := remembertoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "RememberToken.token": %w`, err)}
+		}
+	}
+	if rtuo.mutation.UserCleared() && len(rtuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RememberToken.user"`)
+	}
+	return nil
+}
+
+func (rtuo *RememberTokenUpdateOne) sqlSave(ctx context.Context) (_node *RememberToken, err error) {
+	if err := rtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(remembertoken.Table, remembertoken.Columns, sqlgraph.NewFieldSpec(remembertoken.FieldID, field.TypeInt))
+	id, ok := rtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RememberToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, remembertoken.FieldID)
+		for _, f := range fields {
+			if !remembertoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != remembertoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtuo.mutation.Token(); ok {
+		_spec.SetField(remembertoken.FieldToken, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.PreviousToken(); ok {
+		_spec.SetField(remembertoken.FieldPreviousToken, field.TypeString, value)
+	}
+	if rtuo.mutation.PreviousTokenCleared() {
+		_spec.ClearField(remembertoken.FieldPreviousToken, field.TypeString)
+	}
+	if value, ok := rtuo.mutation.SessionToken(); ok {
+		_spec.SetField(remembertoken.FieldSessionToken, field.TypeString, value)
+	}
+	if rtuo.mutation.SessionTokenCleared() {
+		_spec.ClearField(remembertoken.FieldSessionToken, field.TypeString)
+	}
+	if value, ok := rtuo.mutation.RotatedAt(); ok {
+		_spec.SetField(remembertoken.FieldRotatedAt, field.TypeTime, value)
+	}
+	if rtuo.mutation.RotatedAtCleared() {
+		_spec.ClearField(remembertoken.FieldRotatedAt, field.TypeTime)
+	}
+	_node = &RememberToken{config: rtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{remembertoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rtuo.mutation.done = true
+	return _node, nil
+}
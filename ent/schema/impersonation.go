@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Impersonation holds the schema definition for the Impersonation entity, which records when an admin started or
+// stopped impersonating a user.
+type Impersonation struct {
+	ent.Schema
+}
+
+// Fields of the Impersonation.
+func (Impersonation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("action").
+			Values("start", "stop").
+			Immutable(),
+		field.Int("admin_id").
+			Optional().
+			Immutable(),
+		field.Int("user_id").
+			Optional().
+			Immutable(),
+		// The email addresses are kept so that the record remains meaningful once either user has been deleted.
+		field.String("admin_email").
+			Immutable(),
+		field.String("user_email").
+			Immutable(),
+		field.String("ip").
+			Optional().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Impersonation.
+func (Impersonation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("admin", User.Type).
+			Field("admin_id").
+			Unique().
+			Immutable(),
+		edge.To("user", User.Type).
+			Field("user_id").
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the Impersonation.
+func (Impersonation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("admin_id"),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Invitation holds the schema definition for the Invitation entity.
+type Invitation struct {
+	ent.Schema
+}
+
+// Fields of the Invitation.
+func (Invitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").
+			NotEmpty(),
+		field.String("token").
+			Sensitive().
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.Int("inviter_id").
+			Optional(),
+		field.Int("user_id").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("expires_at"),
+		field.Time("accepted_at").
+			Optional(),
+	}
+}
+
+// Edges of the Invitation.
+func (Invitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("inviter", User.Type).
+			Field("inviter_id").
+			Unique(),
+		edge.To("user", User.Type).
+			Field("user_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Invitation.
+func (Invitation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email"),
+		index.Fields("inviter_id"),
+	}
+}
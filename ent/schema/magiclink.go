@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// MagicLink holds the schema definition for the MagicLink entity.
+type MagicLink struct {
+	ent.Schema
+}
+
+// Fields of the MagicLink.
+func (MagicLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("jti").
+			Sensitive().
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.Int("user_id").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the MagicLink.
+func (MagicLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Field("user_id").
+			Required().
+			Immutable().
+			Unique(),
+	}
+}
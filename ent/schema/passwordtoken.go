@@ -23,7 +23,8 @@ func (PasswordToken) Fields() []ent.Field {
 		field.String("token").
 			Sensitive().
 			NotEmpty(),
-		field.Int("user_id"),
+		field.Int("user_id").
+			Immutable(),
 		field.Time("created_at").
 			Default(time.Now),
 	}
@@ -35,6 +36,7 @@ func (PasswordToken) Edges() []ent.Edge {
 		edge.To("user", User.Type).
 			Field("user_id").
 			Required().
+			Immutable().
 			Unique(),
 	}
 }
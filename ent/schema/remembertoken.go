@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	ge "github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/hook"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// RememberToken holds the schema definition for the RememberToken entity.
+type RememberToken struct {
+	ent.Schema
+}
+
+// Fields of the RememberToken.
+func (RememberToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("series").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("token").
+			Sensitive().
+			NotEmpty(),
+		field.String("previous_token").
+			Sensitive().
+			Optional(),
+		field.String("session_token").
+			Sensitive().
+			Optional(),
+		field.Int("user_id").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("rotated_at").
+			Optional(),
+	}
+}
+
+// Edges of the RememberToken.
+func (RememberToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Field("user_id").
+			Required().
+			Immutable().
+			Unique(),
+	}
+}
+
+// Hooks of the RememberToken.
+func (RememberToken) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.RememberTokenFunc(func(ctx context.Context, m *ge.RememberTokenMutation) (ent.Value, error) {
+					if v, exists := m.Token(); exists {
+						hash, err := bcrypt.GenerateFromPassword([]byte(v), bcrypt.DefaultCost)
+						if err != nil {
+							return "", err
+						}
+						m.SetToken(string(hash))
+					}
+					return next.Mutate(ctx, m)
+				})
+			},
+			// Limit the hook only for these operations.
+			ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne,
+		),
+	}
+}
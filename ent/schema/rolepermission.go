@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RolePermission holds the schema definition for the RolePermission entity, which grants a permission to a role.
+type RolePermission struct {
+	ent.Schema
+}
+
+// Fields of the RolePermission.
+func (RolePermission) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("role_id"),
+		field.Int("permission_id"),
+	}
+}
+
+// Edges of the RolePermission.
+func (RolePermission) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("role", Role.Type).
+			Field("role_id").
+			Required().
+			Unique(),
+		edge.To("permission", Permission.Type).
+			Field("permission_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the RolePermission.
+func (RolePermission) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("role_id", "permission_id").
+			Unique(),
+	}
+}
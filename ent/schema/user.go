@@ -39,6 +39,13 @@ func (User) Fields() []ent.Field {
 			Default(false),
 		field.Bool("admin").
 			Default(false),
+		field.Time("locked_until").
+			Optional(),
+		field.String("totp_secret").
+			Sensitive().
+			Optional(),
+		field.Int64("totp_last_step").
+			Optional(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -50,6 +57,30 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("owner", PasswordToken.Type).
 			Ref("user"),
+		edge.From("login_attempts", LoginAttempt.Type).
+			Ref("user"),
+		edge.From("recovery_codes", RecoveryCode.Type).
+			Ref("user"),
+		edge.From("sessions", Session.Type).
+			Ref("user"),
+		edge.From("remember_tokens", RememberToken.Type).
+			Ref("user"),
+		edge.From("identities", UserIdentity.Type).
+			Ref("user"),
+		edge.From("magic_links", MagicLink.Type).
+			Ref("user"),
+		edge.From("api_tokens", APIToken.Type).
+			Ref("user"),
+		edge.From("impersonations", Impersonation.Type).
+			Ref("admin"),
+		edge.From("impersonated_by", Impersonation.Type).
+			Ref("user"),
+		edge.From("invitations", Invitation.Type).
+			Ref("inviter"),
+		edge.From("webauthn_credentials", WebAuthnCredential.Type).
+			Ref("user"),
+		edge.To("roles", Role.Type).
+			Through("user_roles", UserRole.Type),
 	}
 }
 
@@ -61,6 +92,18 @@ func (User) Hooks() []ent.Hook {
 				return hook.UserFunc(func(ctx context.Context, m *ge.UserMutation) (ent.Value, error) {
 					if v, exists := m.Email(); exists {
 						m.SetEmail(strings.ToLower(v))
+
+						// Changing the email address of a user requires it to be verified again, unless
+						// verification is being set explicitly.
+						if _, set := m.Verified(); !set && m.Op().Is(ent.OpUpdateOne) {
+							old, err := m.OldEmail(ctx)
+							if err != nil {
+								return nil, err
+							}
+							if old != strings.ToLower(v) {
+								m.SetVerified(false)
+							}
+						}
 					}
 
 					if v, exists := m.Password(); exists {
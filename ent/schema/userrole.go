@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserRole holds the schema definition for the UserRole entity, which assigns a role to a user.
+type UserRole struct {
+	ent.Schema
+}
+
+// Fields of the UserRole.
+func (UserRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Int("role_id"),
+	}
+}
+
+// Edges of the UserRole.
+func (UserRole) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Field("user_id").
+			Required().
+			Unique(),
+		edge.To("role", Role.Type).
+			Field("role_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the UserRole.
+func (UserRole) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "role_id").
+			Unique(),
+	}
+}
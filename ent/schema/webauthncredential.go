@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebAuthnCredential holds the schema definition for the WebAuthnCredential entity.
+type WebAuthnCredential struct {
+	ent.Schema
+}
+
+// Fields of the WebAuthnCredential.
+func (WebAuthnCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(),
+		field.String("credential_id").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.Bytes("public_key").
+			Sensitive().
+			NotEmpty().
+			Immutable(),
+		field.String("attestation_type").
+			Optional().
+			Immutable(),
+		field.Strings("transports").
+			Optional(),
+		field.Uint32("sign_count").
+			Default(0),
+		field.Bool("backup_eligible").
+			Default(false).
+			Immutable(),
+		field.Bool("backup_state").
+			Default(false),
+		field.Int("user_id").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_used_at").
+			Optional(),
+	}
+}
+
+// Edges of the WebAuthnCredential.
+func (WebAuthnCredential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Field("user_id").
+			Required().
+			Immutable().
+			Unique(),
+	}
+}
+
+// Indexes of the WebAuthnCredential.
+func (WebAuthnCredential) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,160 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/role"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/ent/userrole"
+)
+
+// UserRole is the model entity for the UserRole schema.
+type UserRole struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// RoleID holds the value of the "role_id" field.
+	RoleID int `json:"role_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserRoleQuery when eager-loading is set.
+	Edges        UserRoleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UserRoleEdges holds the relations/edges for other nodes in the graph.
+type UserRoleEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Role holds the value of the role edge.
+	Role *Role `json:"role,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserRoleEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// RoleOrErr returns the Role value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserRoleEdges) RoleOrErr() (*Role, error) {
+	if e.Role != nil {
+		return e.Role, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: role.Label}
+	}
+	return nil, &NotLoadedError{edge: "role"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserRole) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userrole.FieldID, userrole.FieldUserID, userrole.FieldRoleID:
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserRole fields.
+func (ur *UserRole) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userrole.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ur.ID = int(value.Int64)
+		case userrole.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ur.UserID = int(value.Int64)
+			}
+		case userrole.FieldRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value.Valid {
+				ur.RoleID = int(value.Int64)
+			}
+		default:
+			ur.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserRole.
+// This includes values selected through modifiers, order, etc.
+func (ur *UserRole) Value(name string) (ent.Value, error) {
+	return ur.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the UserRole entity.
+func (ur *UserRole) QueryUser() *UserQuery {
+	return NewUserRoleClient(ur.config).QueryUser(ur)
+}
+
+// QueryRole queries the "role" edge of the UserRole entity.
+func (ur *UserRole) QueryRole() *RoleQuery {
+	return NewUserRoleClient(ur.config).QueryRole(ur)
+}
+
+// Update returns a builder for updating this UserRole.
+// Note that you need to call UserRole.Unwrap() before calling this method if this UserRole
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ur *UserRole) Update() *UserRoleUpdateOne {
+	return NewUserRoleClient(ur.config).UpdateOne(ur)
+}
+
+// Unwrap unwraps the UserRole entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ur *UserRole) Unwrap() *UserRole {
+	_tx, ok := ur.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserRole is not a transactional entity")
+	}
+	ur.config.driver = _tx.drv
+	return ur
+}
+
+// String implements the fmt.Stringer.
+func (ur *UserRole) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserRole(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ur.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ur.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("role_id=")
+	builder.WriteString(fmt.Sprintf("%v", ur.RoleID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserRoles is a parsable slice of UserRole.
+type UserRoles []*UserRole
@@ -0,0 +1,412 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/ent/webauthncredential"
+)
+
+// WebAuthnCredentialCreate is the builder for creating a WebAuthnCredential entity.
+type WebAuthnCredentialCreate struct {
+	config
+	mutation *WebAuthnCredentialMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (wacc *WebAuthnCredentialCreate) SetName(s string) *WebAuthnCredentialCreate {
+	wacc.mutation.SetName(s)
+	return wacc
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (wacc *WebAuthnCredentialCreate) SetCredentialID(s string) *WebAuthnCredentialCreate {
+	wacc.mutation.SetCredentialID(s)
+	return wacc
+}
+
+// SetPublicKey sets the "public_key" field.
+func (wacc *WebAuthnCredentialCreate) SetPublicKey(b []byte) *WebAuthnCredentialCreate {
+	wacc.mutation.SetPublicKey(b)
+	return wacc
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (wacc *WebAuthnCredentialCreate) SetAttestationType(s string) *WebAuthnCredentialCreate {
+	wacc.mutation.SetAttestationType(s)
+	return wacc
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (wacc *WebAuthnCredentialCreate) SetNillableAttestationType(s *string) *WebAuthnCredentialCreate {
+	if s != nil {
+		wacc.SetAttestationType(*s)
+	}
+	return wacc
+}
+
+// SetTransports sets the "transports" field.
+func (wacc *WebAuthnCredentialCreate) SetTransports(s []string) *WebAuthnCredentialCreate {
+	wacc.mutation.SetTransports(s)
+	return wacc
+}
+
+// SetSignCount sets the "sign_count" field.
+func (wacc *WebAuthnCredentialCreate) SetSignCount(u uint32) *WebAuthnCredentialCreate {
+	wacc.mutation.SetSignCount(u)
+	return wacc
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (wacc *WebAuthnCredentialCreate) SetNillableSignCount(u *uint32) *WebAuthnCredentialCreate {
+	if u != nil {
+		wacc.SetSignCount(*u)
+	}
+	return wacc
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (wacc *WebAuthnCredentialCreate) SetBackupEligible(b bool) *WebAuthnCredentialCreate {
+	wacc.mutation.SetBackupEligible(b)
+	return wacc
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (wacc *WebAuthnCredentialCreate) SetNillableBackupEligible(b *bool) *WebAuthnCredentialCreate {
+	if b != nil {
+		wacc.SetBackupEligible(*b)
+	}
+	return wacc
+}
+
+// SetBackupState sets the "backup_state" field.
+func (wacc *WebAuthnCredentialCreate) SetBackupState(b bool) *WebAuthnCredentialCreate {
+	wacc.mutation.SetBackupState(b)
+	return wacc
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (wacc *WebAuthnCredentialCreate) SetNillableBackupState(b *bool) *WebAuthnCredentialCreate {
+	if b != nil {
+		wacc.SetBackupState(*b)
+	}
+	return wacc
+}
+
+// SetUserID sets the "user_id" field.
+func (wacc *WebAuthnCredentialCreate) SetUserID(i int) *WebAuthnCredentialCreate {
+	wacc.mutation.SetUserID(i)
+	return wacc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wacc *WebAuthnCredentialCreate) SetCreatedAt(t time.Time) *WebAuthnCredentialCreate {
+	wacc.mutation.SetCreatedAt(t)
+	return wacc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wacc *WebAuthnCredentialCreate) SetNillableCreatedAt(t *time.Time) *WebAuthnCredentialCreate {
+	if t != nil {
+		wacc.SetCreatedAt(*t)
+	}
+	return wacc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (wacc *WebAuthnCredentialCreate) SetLastUsedAt(t time.Time) *WebAuthnCredentialCreate {
+	wacc.mutation.SetLastUsedAt(t)
+	return wacc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (wacc *WebAuthnCredentialCreate) SetNillableLastUsedAt(t *time.Time) *WebAuthnCredentialCreate {
+	if t != nil {
+		wacc.SetLastUsedAt(*t)
+	}
+	return wacc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (wacc *WebAuthnCredentialCreate) SetUser(u *User) *WebAuthnCredentialCreate {
+	return wacc.SetUserID(u.ID)
+}
+
+// Mutation returns the WebAuthnCredentialMutation object of the builder.
+func (wacc *WebAuthnCredentialCreate) Mutation() *WebAuthnCredentialMutation {
+	return wacc.mutation
+}
+
+// Save creates the WebAuthnCredential in the database.
+func (wacc *WebAuthnCredentialCreate) Save(ctx context.Context) (*WebAuthnCredential, error) {
+	wacc.defaults()
+	return withHooks(ctx, wacc.sqlSave, wacc.mutation, wacc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wacc *WebAuthnCredentialCreate) SaveX(ctx context.Context) *WebAuthnCredential {
+	v, err := wacc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wacc *WebAuthnCredentialCreate) Exec(ctx context.Context) error {
+	_, err := wacc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wacc *WebAuthnCredentialCreate) ExecX(ctx context.Context) {
+	if err := wacc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wacc *WebAuthnCredentialCreate) defaults() {
+	if _, ok := wacc.mutation.SignCount(); !ok {
+		v := webauthncredential.DefaultSignCount
+		wacc.mutation.SetSignCount(v)
+	}
+	if _, ok := wacc.mutation.BackupEligible(); !ok {
+		v := webauthncredential.DefaultBackupEligible
+		wacc.mutation.SetBackupEligible(v)
+	}
+	if _, ok := wacc.mutation.BackupState(); !ok {
+		v := webauthncredential.DefaultBackupState
+		wacc.mutation.SetBackupState(v)
+	}
+	if _, ok := wacc.mutation.CreatedAt(); !ok {
+		v := webauthncredential.DefaultCreatedAt()
+		wacc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wacc *WebAuthnCredentialCreate) check() error {
+	if _, ok := wacc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "WebAuthnCredential.name"`)}
+	}
+	if v, ok := wacc.mutation.Name(); ok {
+		if err := webauthncredential.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WebAuthnCredential.name": %w`, err)}
+		}
+	}
+	if _, ok := wacc.mutation.CredentialID(); !ok {
+		return &ValidationError{Name: "credential_id", err: errors.New(`ent: missing required field "WebAuthnCredential.credential_id"`)}
+	}
+	if v, ok := wacc.mutation.CredentialID(); ok {
+		if err := webauthncredential.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "WebAuthnCredential.credential_id": %w`, err)}
+		}
+	}
+	if _, ok := wacc.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "WebAuthnCredential.public_key"`)}
+	}
+	if v, ok := wacc.mutation.PublicKey(); ok {
+		if err := webauthncredential.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "WebAuthnCredential.public_key": %w`, err)}
+		}
+	}
+	if _, ok := wacc.mutation.SignCount(); !ok {
+		return &ValidationError{Name: "sign_count", err: errors.New(`ent: missing required field "WebAuthnCredential.sign_count"`)}
+	}
+	if _, ok := wacc.mutation.BackupEligible(); !ok {
+		return &ValidationError{Name: "backup_eligible", err: errors.New(`ent: missing required field "WebAuthnCredential.backup_eligible"`)}
+	}
+	if _, ok := wacc.mutation.BackupState(); !ok {
+		return &ValidationError{Name: "backup_state", err: errors.New(`ent: missing required field "WebAuthnCredential.backup_state"`)}
+	}
+	if _, ok := wacc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "WebAuthnCredential.user_id"`)}
+	}
+	if _, ok := wacc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebAuthnCredential.created_at"`)}
+	}
+	if len(wacc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "WebAuthnCredential.user"`)}
+	}
+	return nil
+}
+
+func (wacc *WebAuthnCredentialCreate) sqlSave(ctx context.Context) (*WebAuthnCredential, error) {
+	if err := wacc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wacc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wacc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wacc.mutation.id = &_node.ID
+	wacc.mutation.done = true
+	return _node, nil
+}
+
+func (wacc *WebAuthnCredentialCreate) createSpec() (*WebAuthnCredential, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebAuthnCredential{config: wacc.config}
+		_spec = sqlgraph.NewCreateSpec(webauthncredential.Table, sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeInt))
+	)
+	if value, ok := wacc.mutation.Name(); ok {
+		_spec.SetField(webauthncredential.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := wacc.mutation.CredentialID(); ok {
+		_spec.SetField(webauthncredential.FieldCredentialID, field.TypeString, value)
+		_node.CredentialID = value
+	}
+	if value, ok := wacc.mutation.PublicKey(); ok {
+		_spec.SetField(webauthncredential.FieldPublicKey, field.TypeBytes, value)
+		_node.PublicKey = value
+	}
+	if value, ok := wacc.mutation.AttestationType(); ok {
+		_spec.SetField(webauthncredential.FieldAttestationType, field.TypeString, value)
+		_node.AttestationType = value
+	}
+	if value, ok := wacc.mutation.Transports(); ok {
+		_spec.SetField(webauthncredential.FieldTransports, field.TypeJSON, value)
+		_node.Transports = value
+	}
+	if value, ok := wacc.mutation.SignCount(); ok {
+		_spec.SetField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+		_node.SignCount = value
+	}
+	if value, ok := wacc.mutation.BackupEligible(); ok {
+		_spec.SetField(webauthncredential.FieldBackupEligible, field.TypeBool, value)
+		_node.BackupEligible = value
+	}
+	if value, ok := wacc.mutation.BackupState(); ok {
+		_spec.SetField(webauthncredential.FieldBackupState, field.TypeBool, value)
+		_node.BackupState = value
+	}
+	if value, ok := wacc.mutation.CreatedAt(); ok {
+		_spec.SetField(webauthncredential.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wacc.mutation.LastUsedAt(); ok {
+		_spec.SetField(webauthncredential.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = value
+	}
+	if nodes := wacc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   webauthncredential.UserTable,
+			Columns: []string{webauthncredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WebAuthnCredentialCreateBulk is the builder for creating many WebAuthnCredential entities in bulk.
+type WebAuthnCredentialCreateBulk struct {
+	config
+	err      error
+	builders []*WebAuthnCredentialCreate
+}
+
+// Save creates the WebAuthnCredential entities in the database.
+func (waccb *WebAuthnCredentialCreateBulk) Save(ctx context.Context) ([]*WebAuthnCredential, error) {
+	if waccb.err != nil {
+		return nil, waccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(waccb.builders))
+	nodes := make([]*WebAuthnCredential, len(waccb.builders))
+	mutators := make([]Mutator, len(waccb.builders))
+	for i := range waccb.builders {
+		func(i int, root context.Context) {
+			builder := waccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebAuthnCredentialMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, waccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, waccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, waccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (waccb *WebAuthnCredentialCreateBulk) SaveX(ctx context.Context) []*WebAuthnCredential {
+	v, err := waccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (waccb *WebAuthnCredentialCreateBulk) Exec(ctx context.Context) error {
+	_, err := waccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (waccb *WebAuthnCredentialCreateBulk) ExecX(ctx context.Context) {
+	if err := waccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
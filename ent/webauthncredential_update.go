@@ -0,0 +1,447 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/webauthncredential"
+)
+
+// WebAuthnCredentialUpdate is the builder for updating WebAuthnCredential entities.
+type WebAuthnCredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebAuthnCredentialMutation
+}
+
+// Where appends a list predicates to the WebAuthnCredentialUpdate builder.
+func (wacu *WebAuthnCredentialUpdate) Where(ps ...predicate.WebAuthnCredential) *WebAuthnCredentialUpdate {
+	wacu.mutation.Where(ps...)
+	return wacu
+}
+
+// SetName sets the "name" field.
+func (wacu *WebAuthnCredentialUpdate) SetName(s string) *WebAuthnCredentialUpdate {
+	wacu.mutation.SetName(s)
+	return wacu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wacu *WebAuthnCredentialUpdate) SetNillableName(s *string) *WebAuthnCredentialUpdate {
+	if s != nil {
+		wacu.SetName(*s)
+	}
+	return wacu
+}
+
+// SetTransports sets the "transports" field.
+func (wacu *WebAuthnCredentialUpdate) SetTransports(s []string) *WebAuthnCredentialUpdate {
+	wacu.mutation.SetTransports(s)
+	return wacu
+}
+
+// AppendTransports appends s to the "transports" field.
+func (wacu *WebAuthnCredentialUpdate) AppendTransports(s []string) *WebAuthnCredentialUpdate {
+	wacu.mutation.AppendTransports(s)
+	return wacu
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (wacu *WebAuthnCredentialUpdate) ClearTransports() *WebAuthnCredentialUpdate {
+	wacu.mutation.ClearTransports()
+	return wacu
+}
+
+// SetSignCount sets the "sign_count" field.
+func (wacu *WebAuthnCredentialUpdate) SetSignCount(u uint32) *WebAuthnCredentialUpdate {
+	wacu.mutation.ResetSignCount()
+	wacu.mutation.SetSignCount(u)
+	return wacu
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (wacu *WebAuthnCredentialUpdate) SetNillableSignCount(u *uint32) *WebAuthnCredentialUpdate {
+	if u != nil {
+		wacu.SetSignCount(*u)
+	}
+	return wacu
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (wacu *WebAuthnCredentialUpdate) AddSignCount(u int32) *WebAuthnCredentialUpdate {
+	wacu.mutation.AddSignCount(u)
+	return wacu
+}
+
+// SetBackupState sets the "backup_state" field.
+func (wacu *WebAuthnCredentialUpdate) SetBackupState(b bool) *WebAuthnCredentialUpdate {
+	wacu.mutation.SetBackupState(b)
+	return wacu
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (wacu *WebAuthnCredentialUpdate) SetNillableBackupState(b *bool) *WebAuthnCredentialUpdate {
+	if b != nil {
+		wacu.SetBackupState(*b)
+	}
+	return wacu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (wacu *WebAuthnCredentialUpdate) SetLastUsedAt(t time.Time) *WebAuthnCredentialUpdate {
+	wacu.mutation.SetLastUsedAt(t)
+	return wacu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (wacu *WebAuthnCredentialUpdate) SetNillableLastUsedAt(t *time.Time) *WebAuthnCredentialUpdate {
+	if t != nil {
+		wacu.SetLastUsedAt(*t)
+	}
+	return wacu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (wacu *WebAuthnCredentialUpdate) ClearLastUsedAt() *WebAuthnCredentialUpdate {
+	wacu.mutation.ClearLastUsedAt()
+	return wacu
+}
+
+// Mutation returns the WebAuthnCredentialMutation object of the builder.
+func (wacu *WebAuthnCredentialUpdate) Mutation() *WebAuthnCredentialMutation {
+	return wacu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wacu *WebAuthnCredentialUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wacu.sqlSave, wacu.mutation, wacu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wacu *WebAuthnCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := wacu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wacu *WebAuthnCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := wacu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wacu *WebAuthnCredentialUpdate) ExecX(ctx context.Context) {
+	if err := wacu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wacu *WebAuthnCredentialUpdate) check() error {
+	if v, ok := wacu.mutation.Name(); ok {
+		if err := webauthncredential.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WebAuthnCredential.name": %w`, err)}
+		}
+	}
+	if wacu.mutation.UserCleared() && len(wacu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebAuthnCredential.user"`)
+	}
+	return nil
+}
+
+func (wacu *WebAuthnCredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wacu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webauthncredential.Table, webauthncredential.Columns, sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeInt))
+	if ps := wacu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wacu.mutation.Name(); ok {
+		_spec.SetField(webauthncredential.FieldName, field.TypeString, value)
+	}
+	if wacu.mutation.AttestationTypeCleared() {
+		_spec.ClearField(webauthncredential.FieldAttestationType, field.TypeString)
+	}
+	if value, ok := wacu.mutation.Transports(); ok {
+		_spec.SetField(webauthncredential.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := wacu.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webauthncredential.FieldTransports, value)
+		})
+	}
+	if wacu.mutation.TransportsCleared() {
+		_spec.ClearField(webauthncredential.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := wacu.mutation.SignCount(); ok {
+		_spec.SetField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := wacu.mutation.AddedSignCount(); ok {
+		_spec.AddField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := wacu.mutation.BackupState(); ok {
+		_spec.SetField(webauthncredential.FieldBackupState, field.TypeBool, value)
+	}
+	if value, ok := wacu.mutation.LastUsedAt(); ok {
+		_spec.SetField(webauthncredential.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if wacu.mutation.LastUsedAtCleared() {
+		_spec.ClearField(webauthncredential.FieldLastUsedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wacu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthncredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wacu.mutation.done = true
+	return n, nil
+}
+
+// WebAuthnCredentialUpdateOne is the builder for updating a single WebAuthnCredential entity.
+type WebAuthnCredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebAuthnCredentialMutation
+}
+
+// SetName sets the "name" field.
+func (wacuo *WebAuthnCredentialUpdateOne) SetName(s string) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.SetName(s)
+	return wacuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wacuo *WebAuthnCredentialUpdateOne) SetNillableName(s *string) *WebAuthnCredentialUpdateOne {
+	if s != nil {
+		wacuo.SetName(*s)
+	}
+	return wacuo
+}
+
+// SetTransports sets the "transports" field.
+func (wacuo *WebAuthnCredentialUpdateOne) SetTransports(s []string) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.SetTransports(s)
+	return wacuo
+}
+
+// AppendTransports appends s to the "transports" field.
+func (wacuo *WebAuthnCredentialUpdateOne) AppendTransports(s []string) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.AppendTransports(s)
+	return wacuo
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (wacuo *WebAuthnCredentialUpdateOne) ClearTransports() *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.ClearTransports()
+	return wacuo
+}
+
+// SetSignCount sets the "sign_count" field.
+func (wacuo *WebAuthnCredentialUpdateOne) SetSignCount(u uint32) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.ResetSignCount()
+	wacuo.mutation.SetSignCount(u)
+	return wacuo
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (wacuo *WebAuthnCredentialUpdateOne) SetNillableSignCount(u *uint32) *WebAuthnCredentialUpdateOne {
+	if u != nil {
+		wacuo.SetSignCount(*u)
+	}
+	return wacuo
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (wacuo *WebAuthnCredentialUpdateOne) AddSignCount(u int32) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.AddSignCount(u)
+	return wacuo
+}
+
+// SetBackupState sets the "backup_state" field.
+func (wacuo *WebAuthnCredentialUpdateOne) SetBackupState(b bool) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.SetBackupState(b)
+	return wacuo
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (wacuo *WebAuthnCredentialUpdateOne) SetNillableBackupState(b *bool) *WebAuthnCredentialUpdateOne {
+	if b != nil {
+		wacuo.SetBackupState(*b)
+	}
+	return wacuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (wacuo *WebAuthnCredentialUpdateOne) SetLastUsedAt(t time.Time) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.SetLastUsedAt(t)
+	return wacuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (wacuo *WebAuthnCredentialUpdateOne) SetNillableLastUsedAt(t *time.Time) *WebAuthnCredentialUpdateOne {
+	if t != nil {
+		wacuo.SetLastUsedAt(*t)
+	}
+	return wacuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (wacuo *WebAuthnCredentialUpdateOne) ClearLastUsedAt() *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.ClearLastUsedAt()
+	return wacuo
+}
+
+// Mutation returns the WebAuthnCredentialMutation object of the builder.
+func (wacuo *WebAuthnCredentialUpdateOne) Mutation() *WebAuthnCredentialMutation {
+	return wacuo.mutation
+}
+
+// Where appends a list predicates to the WebAuthnCredentialUpdate builder.
+func (wacuo *WebAuthnCredentialUpdateOne) Where(ps ...predicate.WebAuthnCredential) *WebAuthnCredentialUpdateOne {
+	wacuo.mutation.Where(ps...)
+	return wacuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wacuo *WebAuthnCredentialUpdateOne) Select(field string, fields ...string) *WebAuthnCredentialUpdateOne {
+	wacuo.fields = append([]string{field}, fields...)
+	return wacuo
+}
+
+// Save executes the query and returns the updated WebAuthnCredential entity.
+func (wacuo *WebAuthnCredentialUpdateOne) Save(ctx context.Context) (*WebAuthnCredential, error) {
+	return withHooks(ctx, wacuo.sqlSave, wacuo.mutation, wacuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wacuo *WebAuthnCredentialUpdateOne) SaveX(ctx context.Context) *WebAuthnCredential {
+	node, err := wacuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wacuo *WebAuthnCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := wacuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wacuo *WebAuthnCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := wacuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wacuo *WebAuthnCredentialUpdateOne) check() error {
+	if v, ok := wacuo.mutation.Name(); ok {
+		if err := webauthncredential.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WebAuthnCredential.name": %w`, err)}
+		}
+	}
+	if wacuo.mutation.UserCleared() && len(wacuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebAuthnCredential.user"`)
+	}
+	return nil
+}
+
+func (wacuo *WebAuthnCredentialUpdateOne) sqlSave(ctx context.Context) (_node *WebAuthnCredential, err error) {
+	if err := wacuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webauthncredential.Table, webauthncredential.Columns, sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeInt))
+	id, ok := wacuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebAuthnCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wacuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webauthncredential.FieldID)
+		for _, f := range fields {
+			if !webauthncredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webauthncredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wacuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wacuo.mutation.Name(); ok {
+		_spec.SetField(webauthncredential.FieldName, field.TypeString, value)
+	}
+	if wacuo.mutation.AttestationTypeCleared() {
+		_spec.ClearField(webauthncredential.FieldAttestationType, field.TypeString)
+	}
+	if value, ok := wacuo.mutation.Transports(); ok {
+		_spec.SetField(webauthncredential.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := wacuo.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webauthncredential.FieldTransports, value)
+		})
+	}
+	if wacuo.mutation.TransportsCleared() {
+		_spec.ClearField(webauthncredential.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := wacuo.mutation.SignCount(); ok {
+		_spec.SetField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := wacuo.mutation.AddedSignCount(); ok {
+		_spec.AddField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := wacuo.mutation.BackupState(); ok {
+		_spec.SetField(webauthncredential.FieldBackupState, field.TypeBool, value)
+	}
+	if value, ok := wacuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(webauthncredential.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if wacuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(webauthncredential.FieldLastUsedAt, field.TypeTime)
+	}
+	_node = &WebAuthnCredential{config: wacuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wacuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthncredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wacuo.mutation.done = true
+	return _node, nil
+}
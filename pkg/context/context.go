@@ -11,6 +11,15 @@ const (
 	// AuthenticatedUserKey is the key used to store the authenticated user in context.
 	AuthenticatedUserKey = "auth_user"
 
+	// APITokenKey is the key used to store the API token that authenticated the request in context.
+	APITokenKey = "api_token"
+
+	// ImpersonatorKey is the key used to store the admin impersonating the authenticated user in context.
+	ImpersonatorKey = "auth_impersonator"
+
+	// PermissionsKey is the key used to store the names of the permissions of the authenticated user in context.
+	PermissionsKey = "auth_user_permissions"
+
 	// UserKey is the key used to store a user in context.
 	UserKey = "user"
 
@@ -32,10 +32,11 @@ type Form interface {
 	GetFieldErrors(fieldName string) []string
 }
 
-// Get gets a form from the context or initializes a new copy if one is not set.
+// Get gets a form from the context or initializes a new copy if one of its type is not set, such as when a page
+// contains multiple forms and a different one was submitted.
 func Get[T any](ctx echo.Context) *T {
-	if v := ctx.Get(context.FormKey); v != nil {
-		return v.(*T)
+	if v, ok := ctx.Get(context.FormKey).(*T); ok {
+		return v
 	}
 	var v T
 	return &v
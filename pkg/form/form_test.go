@@ -54,6 +54,12 @@ func TestGetClear(t *testing.T) {
 		require.NotNil(t, got)
 		assert.Equal(t, "test", form.Name)
 
+		// Forms of other types are not returned
+		type other struct {
+			Name string `form:"name"`
+		}
+		assert.Empty(t, Get[other](ctx).Name)
+
 		// Clear
 		Clear(ctx)
 		got = Get[example](ctx)
@@ -87,7 +87,7 @@ func (f *Submission) setErrorMessages(err error) {
 		// Provide better error messages depending on the failed validation tag.
 		// This should be expanded as you use additional tags in your validation.
 		switch ve.Tag() {
-		case "required":
+		case "required", "required_without", "required_without_all":
 			message = "This field is required."
 		case "email":
 			message = "Enter a valid email address."
@@ -95,6 +95,10 @@ func (f *Submission) setErrorMessages(err error) {
 			message = "Does not match."
 		case "gte":
 			message = fmt.Sprintf("Must be greater than or equal to %v.", ve.Param())
+		case "password":
+			message = "Does not meet the password requirements."
+		case "notbreached":
+			message = "This password has appeared in a data breach. Choose a different password."
 		default:
 			message = "Invalid value."
 		}
@@ -1,25 +1,34 @@
 package handlers
 
 import (
+	goctx "context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
 
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/entc/load"
+	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	// "github.com/mikestefanello/backlite/ui" // Removed Backlite UI
 	"github.com/mikestefanello/pagoda/ent"
 	"github.com/mikestefanello/pagoda/ent/admin"
+	"github.com/mikestefanello/pagoda/ent/user"
 	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/form"
+	"github.com/mikestefanello/pagoda/pkg/log"
 	"github.com/mikestefanello/pagoda/pkg/middleware"
 	"github.com/mikestefanello/pagoda/pkg/msg"
 	"github.com/mikestefanello/pagoda/pkg/pager"
+	"github.com/mikestefanello/pagoda/pkg/permissions"
 	"github.com/mikestefanello/pagoda/pkg/redirect"
 	"github.com/mikestefanello/pagoda/pkg/routenames"
 	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/ui/forms"
+	"github.com/mikestefanello/pagoda/pkg/ui/models"
 	"github.com/mikestefanello/pagoda/pkg/ui/pages"
 )
 
@@ -27,6 +36,8 @@ type Admin struct {
 	orm   *ent.Client
 	graph *gen.Graph
 	admin *admin.Handler
+	auth  *services.AuthClient
+	cache *services.CacheClient
 	// backlite *ui.Handler // Removed Backlite UI
 }
 
@@ -38,6 +49,8 @@ func (h *Admin) Init(c *services.Container) error {
 	var err error
 	h.graph = c.Graph
 	h.orm = c.ORM
+	h.auth = c.Auth
+	h.cache = c.Cache
 	h.admin = admin.NewHandler(h.orm, admin.HandlerConfig{
 		ItemsPerPage: 25,
 		PageQueryKey: pager.QueryKey,
@@ -50,31 +63,67 @@ func (h *Admin) Init(c *services.Container) error {
 	// 	ReleaseAfter: c.Config.Tasks.ReleaseAfter,
 	// })
 	// return err // Removed Backlite UI
-	return nil
+
+	// Create the permissions for each entity type so they can be granted to roles.
+	names := make([]string, 0, len(h.graph.Nodes)*len(permissions.EntityActions))
+	for _, n := range h.graph.Nodes {
+		if permissions.AdminOnly(n.Name) {
+			continue
+		}
+		for _, action := range permissions.EntityActionsOf(n.Name) {
+			names = append(names, permissions.Entity(n.Name, action))
+		}
+	}
+	return h.auth.RegisterPermissions(goctx.Background(), names...)
 }
 
 func (h *Admin) Routes(g *echo.Group) {
-	ag := g.Group("/admin", middleware.RequireAdmin)
+	ag := g.Group("/admin", middleware.RequireAuthentication)
 
+	// Entities can be managed by users with the permission for each action on each entity type, except for those
+	// which only admins can manage. Read-only entity types can only be listed.
 	entities := ag.Group("/entity")
 	for _, n := range h.graph.Nodes {
+		can := func(action string) echo.MiddlewareFunc {
+			if permissions.AdminOnly(n.Name) {
+				return middleware.RequireAdmin
+			}
+			return middleware.RequirePermission(permissions.Entity(n.Name, action))
+		}
+		load := h.middlewareEntityLoad(n)
+		guard := h.middlewareAdminUser(n)
+
 		ng := entities.Group(fmt.Sprintf("/%s", strings.ToLower(n.Name)))
-		ng.GET("", h.EntityList(n)).
+		ng.GET("", h.EntityList(n), can(permissions.ActionList)).
 			Name = routenames.AdminEntityList(n.Name)
-		ng.GET("/add", h.EntityAdd(n)).
+		if !permissions.CanEntity(n.Name, permissions.ActionAdd) {
+			continue
+		}
+		ng.GET("/add", h.EntityAdd(n), can(permissions.ActionAdd)).
 			Name = routenames.AdminEntityAdd(n.Name)
-		ng.POST("/add", h.EntityAddSubmit(n)).
+		ng.POST("/add", h.EntityAddSubmit(n), can(permissions.ActionAdd), guard).
 			Name = routenames.AdminEntityAddSubmit(n.Name)
-		ng.GET("/:id/edit", h.EntityEdit(n), h.middlewareEntityLoad(n)).
+		ng.GET("/:id/edit", h.EntityEdit(n), can(permissions.ActionEdit), load, guard).
 			Name = routenames.AdminEntityEdit(n.Name)
-		ng.POST("/:id/edit", h.EntityEditSubmit(n), h.middlewareEntityLoad(n)).
+		ng.POST("/:id/edit", h.EntityEditSubmit(n), can(permissions.ActionEdit), load, guard).
 			Name = routenames.AdminEntityEditSubmit(n.Name)
-		ng.GET("/:id/delete", h.EntityDelete(n), h.middlewareEntityLoad(n)).
+		ng.GET("/:id/delete", h.EntityDelete(n), can(permissions.ActionDelete), load, guard).
 			Name = routenames.AdminEntityDelete(n.Name)
-		ng.POST("/:id/delete", h.EntityDeleteSubmit(n), h.middlewareEntityLoad(n)).
+		ng.POST("/:id/delete", h.EntityDeleteSubmit(n), can(permissions.ActionDelete), load, guard).
 			Name = routenames.AdminEntityDeleteSubmit(n.Name)
 	}
 
+	// Stopping is not limited to admins since the session is authenticated as the impersonated user.
+	ag.POST("/impersonate/stop", h.ImpersonateStop).Name = routenames.AdminImpersonateStop
+	ag.POST("/impersonate/:user", h.Impersonate,
+		middleware.RequireNoAPIToken,
+		middleware.RequireNoImpersonation,
+		middleware.RequireAdmin,
+	).Name = routenames.AdminImpersonate
+
+	ag.GET("/cache", h.CachePage, middleware.RequireAdmin).Name = routenames.AdminCache
+	ag.POST("/cache", h.CacheSubmit, middleware.RequireAdmin).Name = routenames.AdminCacheSubmit
+
 	// tasks := ag.Group("/tasks") // Removed Backlite UI
 	// tasks.GET("", h.Backlite(h.backlite.Running)).Name = routenames.AdminTasks // Removed Backlite UI
 	// tasks.GET("/succeeded", h.Backlite(h.backlite.Succeeded)) // Removed Backlite UI
@@ -108,6 +157,62 @@ func (h *Admin) middlewareEntityLoad(n *gen.Type) echo.MiddlewareFunc {
 	}
 }
 
+// middlewareAdminUser is middleware which prevents users who are not admins from making a user an admin, or from
+// managing a user who is an admin, or anything which belongs to one, since either would let them escalate their own
+// privileges. This requires that the entity being managed, if any, is loaded in to context.
+func (h *Admin) middlewareAdminUser(n *gen.Type) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if ctx.Get(context.AuthenticatedUserKey).(*ent.User).Admin {
+				return next(ctx)
+			}
+
+			// Collect the users that the entity belongs to, both before and after the change.
+			var ids []string
+			if n.Name == "User" {
+				if admin, _ := strconv.ParseBool(ctx.FormValue("admin")); admin {
+					return echo.NewHTTPError(http.StatusForbidden, "only admins can make users admins")
+				}
+
+				if id, ok := ctx.Get(context.AdminEntityIDKey).(int); ok {
+					ids = append(ids, strconv.Itoa(id))
+				}
+			} else {
+				if entity, ok := ctx.Get(context.AdminEntityKey).(map[string][]string); ok {
+					ids = append(ids, url.Values(entity).Get("user_id"))
+				}
+				ids = append(ids, ctx.FormValue("user_id"))
+			}
+
+			userIDs := make([]int, 0, len(ids))
+			for _, id := range ids {
+				// Values which are not IDs are left to fail validation.
+				if userID, err := strconv.Atoi(id); err == nil {
+					userIDs = append(userIDs, userID)
+				}
+			}
+
+			if len(userIDs) > 0 {
+				admin, err := h.orm.User.
+					Query().
+					Where(
+						user.IDIn(userIDs...),
+						user.Admin(true),
+					).
+					Exist(ctx.Request().Context())
+				if err != nil {
+					return fail(err, "unable to load user")
+				}
+				if admin {
+					return echo.NewHTTPError(http.StatusForbidden, "only admins can manage admins")
+				}
+			}
+
+			return next(ctx)
+		}
+	}
+}
+
 func (h *Admin) EntityList(n *gen.Type) echo.HandlerFunc {
 	return func(ctx echo.Context) error {
 		list, err := h.admin.List(ctx, n.Name)
@@ -193,6 +298,166 @@ func (h *Admin) EntityDeleteSubmit(n *gen.Type) echo.HandlerFunc {
 	}
 }
 
+func (h *Admin) CachePage(ctx echo.Context) error {
+	stats := h.cache.Stats()
+
+	tags, err := h.cache.Tags(ctx.Request().Context())
+	if err != nil {
+		return fail(err, "failed to list cache tags")
+	}
+
+	m := &models.CacheStats{
+		Groups:     make([]*models.CacheGroup, 0, len(stats.Groups)),
+		Tags:       make([]*models.CacheTag, 0, len(tags)),
+		TagFlushes: stats.TagFlushes,
+	}
+
+	for _, g := range stats.Groups {
+		group := &models.CacheGroup{
+			Name:     g.Group,
+			Hits:     g.Hits,
+			Misses:   g.Misses,
+			HitRatio: g.HitRatio(),
+			Sets:     g.Sets,
+			Flushes:  g.Flushes,
+		}
+
+		for _, cause := range []services.CacheEvictionCause{
+			services.CacheEvictionExpired,
+			services.CacheEvictionSize,
+			services.CacheEvictionTagIndex,
+		} {
+			if n := g.Evictions[cause]; n > 0 {
+				group.Evictions = append(group.Evictions, models.CacheEviction{
+					Cause: cause.String(),
+					Count: n,
+				})
+			}
+		}
+
+		m.Groups = append(m.Groups, group)
+	}
+
+	for _, t := range tags {
+		m.Tags = append(m.Tags, &models.CacheTag{
+			Name: t.Name,
+			Keys: t.Keys,
+		})
+	}
+
+	if stats.TagIndex != nil {
+		m.TagIndex = &models.CacheTagIndex{
+			Tags:      stats.TagIndex.Tags,
+			Keys:      stats.TagIndex.Keys,
+			Capacity:  stats.TagIndex.Capacity,
+			Evictions: stats.TagIndex.Evictions,
+		}
+	}
+
+	return pages.AdminCache(ctx, m, form.Get[forms.AdminCacheFlush](ctx))
+}
+
+func (h *Admin) CacheSubmit(ctx echo.Context) error {
+	var input forms.AdminCacheFlush
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.CachePage(ctx)
+	default:
+		return err
+	}
+
+	op := h.cache.Flush()
+	switch {
+	case input.Key != "":
+		op.Group(input.Group).Key(input.Key)
+	case input.Group != "":
+		op.Group(input.Group).All()
+	}
+	if input.Tag != "" {
+		op.Tags(input.Tag)
+	}
+
+	if err := op.Execute(ctx.Request().Context()); err != nil {
+		return fail(err, "unable to flush cache")
+	}
+
+	msg.Success(ctx, "The cache has been flushed.")
+
+	return redirect.
+		New(ctx).
+		Route(routenames.AdminCache).
+		StatusCode(http.StatusFound).
+		Go()
+}
+
+func (h *Admin) Impersonate(ctx echo.Context) error {
+	admin := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	id, err := strconv.Atoi(ctx.Param("user"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid user ID")
+	}
+
+	usr, err := h.orm.User.Get(ctx.Request().Context(), id)
+	switch {
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound, "user not found")
+	case err != nil:
+		return fail(err, "unable to load user")
+	}
+
+	err = h.auth.Impersonate(ctx, admin, usr)
+	switch err.(type) {
+	case nil:
+	case services.ImpersonationNotAllowedError:
+		msg.Error(ctx, "You cannot impersonate this user.")
+		return redirect.New(ctx).
+			Route(routenames.AdminEntityList("User")).
+			StatusCode(http.StatusFound).
+			Go()
+	default:
+		return fail(err, "unable to impersonate user")
+	}
+
+	log.Ctx(ctx).Info("admin started impersonating user",
+		"admin_id", admin.ID,
+		"user_id", usr.ID,
+	)
+
+	return redirect.New(ctx).
+		Route(routenames.Home).
+		StatusCode(http.StatusFound).
+		Go()
+}
+
+func (h *Admin) ImpersonateStop(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	admin, err := h.auth.StopImpersonating(ctx)
+	switch err.(type) {
+	case nil:
+	case services.NotImpersonatingError, services.ImpersonationNotAllowedError:
+		return echo.NewHTTPError(http.StatusBadRequest, "not impersonating a user")
+	default:
+		return fail(err, "unable to stop impersonating user")
+	}
+
+	log.Ctx(ctx).Info("admin stopped impersonating user",
+		"admin_id", admin.ID,
+		"user_id", usr.ID,
+	)
+
+	msg.Info(ctx, fmt.Sprintf("You are no longer impersonating %s.", usr.Name))
+	return redirect.New(ctx).
+		Route(routenames.AdminEntityList("User")).
+		StatusCode(http.StatusFound).
+		Go()
+}
+
 func (h *Admin) getEntitySchema(n *gen.Type) *load.Schema {
 	for _, s := range h.graph.Schemas {
 		if s.Name == n.Name {
@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/impersonation"
+	"github.com/mikestefanello/pagoda/ent/permission"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/pkg/permissions"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createVerifiedUser(t *testing.T, admin bool) *ent.User {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	u, err = u.Update().SetAdmin(admin).SetVerified(true).Save(context.Background())
+	require.NoError(t, err)
+	return u
+}
+
+func TestAdmin__EntityPermissions(t *testing.T) {
+	ctx := context.Background()
+	admin := createVerifiedUser(t, true)
+	target := createVerifiedUser(t, false)
+
+	// Grant a user every permission on users and login attempts, as well as on the entities that only admins can
+	// manage, in case they were granted before those entities became admin only.
+	names := make([]string, 0)
+	adminEntities := []string{
+		"Role", "Permission", "UserRole", "RolePermission", "APIToken", "MagicLink", "PasswordToken", "RecoveryCode",
+		"RememberToken", "Session", "UserIdentity", "WebAuthnCredential",
+	}
+	for _, entityTypeName := range append([]string{"User", "LoginAttempt"}, adminEntities...) {
+		for _, action := range permissions.EntityActions {
+			names = append(names, permissions.Entity(entityTypeName, action))
+		}
+	}
+	require.NoError(t, c.Auth.RegisterPermissions(ctx, names...))
+	perms, err := c.ORM.Permission.Query().All(ctx)
+	require.NoError(t, err)
+
+	manager := createVerifiedUser(t, false)
+	err = c.ORM.Role.
+		Create().
+		SetName("test user manager").
+		AddPermissions(perms...).
+		AddUsers(manager).
+		Exec(ctx)
+	require.NoError(t, err)
+
+	s := login(t, manager.Email)
+
+	userForm := func(u *ent.User, admin bool) url.Values {
+		v := url.Values{
+			"name":     []string{u.Name},
+			"email":    []string{u.Email},
+			"verified": []string{"true"},
+		}
+		if admin {
+			v.Set("admin", "true")
+		}
+		return v
+	}
+
+	// Users who are not admins cannot make any user an admin, including themselves.
+	resp := s.post(routeURL(routenames.AdminEntityEditSubmit("User"), manager.ID), userForm(manager, true))
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = s.post(routeURL(routenames.AdminEntityEditSubmit("User"), target.ID), userForm(target, true))
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	added := &ent.User{Name: "Added", Email: "added-admin@localhost.localhost"}
+	resp = s.post(routeURL(routenames.AdminEntityAddSubmit("User")), userForm(added, true))
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	n, err := c.ORM.User.Query().Where(user.Admin(true), user.IDIn(manager.ID, target.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	exists, err := c.ORM.User.Query().Where(user.Email(added.Email)).Exist(ctx)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// But they can still manage other users.
+	resp = s.post(routeURL(routenames.AdminEntityEditSubmit("User"), target.ID), userForm(target, false))
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+
+	// Users who are admins cannot be managed by users who are not.
+	for _, r := range []string{routenames.AdminEntityEdit("User"), routenames.AdminEntityDelete("User")} {
+		resp = s.get(routeURL(r, admin.ID))
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	}
+	for _, r := range []string{routenames.AdminEntityEditSubmit("User"), routenames.AdminEntityDeleteSubmit("User")} {
+		resp = s.post(routeURL(r, admin.ID), userForm(admin, false))
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	}
+	admin, err = c.ORM.User.Get(ctx, admin.ID)
+	require.NoError(t, err)
+	assert.True(t, admin.Admin)
+
+	// Nor can they manage anything which belongs to an admin.
+	attempt, err := c.ORM.LoginAttempt.
+		Create().
+		SetEmail(admin.Email).
+		SetIP("10.1.1.3").
+		SetUserID(admin.ID).
+		Save(ctx)
+	require.NoError(t, err)
+	attemptForm := func(userID int) url.Values {
+		return url.Values{
+			"email":   []string{admin.Email},
+			"ip":      []string{"10.1.1.3"},
+			"user_id": []string{strconv.Itoa(userID)},
+		}
+	}
+	resp = s.post(routeURL(routenames.AdminEntityEditSubmit("LoginAttempt"), attempt.ID), attemptForm(manager.ID))
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = s.post(routeURL(routenames.AdminEntityDeleteSubmit("LoginAttempt"), attempt.ID), nil)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = s.post(routeURL(routenames.AdminEntityAddSubmit("LoginAttempt")), attemptForm(admin.ID))
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = s.post(routeURL(routenames.AdminEntityAddSubmit("LoginAttempt")), attemptForm(target.ID))
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+
+	// Roles, permissions, their assignments and credentials can only be managed by admins, regardless of permissions.
+	for _, entityTypeName := range adminEntities {
+		t.Run(entityTypeName, func(t *testing.T) {
+			resp := s.get(routeURL(routenames.AdminEntityList(entityTypeName)))
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+			resp = s.get(routeURL(routenames.AdminEntityAdd(entityTypeName)))
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+			resp = s.post(routeURL(routenames.AdminEntityAddSubmit(entityTypeName)), nil)
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		})
+	}
+
+	// Admins can do all of the above.
+	s = login(t, admin.Email)
+	resp = s.post(routeURL(routenames.AdminEntityEditSubmit("User"), target.ID), userForm(target, true))
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	target, err = c.ORM.User.Get(ctx, target.ID)
+	require.NoError(t, err)
+	assert.True(t, target.Admin)
+
+	resp = s.get(routeURL(routenames.AdminEntityList("Role")))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestAdmin__Impersonation(t *testing.T) {
+	admin := createVerifiedUser(t, true)
+	usr := createVerifiedUser(t, false)
+
+	// Start impersonating the user.
+	s := login(t, admin.Email)
+	resp := s.post(routeURL(routenames.AdminImpersonate, usr.ID), nil)
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	// The credentials and account of the user cannot be managed while impersonating them.
+	routes := []struct {
+		method string
+		name   string
+		params []any
+	}{
+		{http.MethodGet, routenames.Settings, nil},
+		{http.MethodPost, routenames.SettingsName, nil},
+		{http.MethodPost, routenames.SettingsEmail, nil},
+		{http.MethodPost, routenames.SettingsPassword, nil},
+		{http.MethodPost, routenames.SettingsDelete, nil},
+		{http.MethodGet, routenames.TwoFactor, nil},
+		{http.MethodPost, routenames.TwoFactorEnable, nil},
+		{http.MethodPost, routenames.TwoFactorDisable, nil},
+		{http.MethodGet, routenames.Sessions, nil},
+		{http.MethodPost, routenames.SessionRevoke, []any{"abc"}},
+		{http.MethodGet, routenames.APITokens, nil},
+		{http.MethodPost, routenames.APITokenCreate, nil},
+		{http.MethodPost, routenames.APITokenRevoke, []any{1}},
+		{http.MethodGet, routenames.Passkeys, nil},
+		{http.MethodGet, routenames.PasskeyRegister, nil},
+		{http.MethodPost, routenames.PasskeyCreate, nil},
+		{http.MethodPost, routenames.PasskeyDelete, []any{1}},
+		{http.MethodGet, routenames.Invitations, nil},
+		{http.MethodPost, routenames.InvitationCreate, nil},
+		{http.MethodPost, routenames.InvitationRevoke, []any{1}},
+		{http.MethodPost, routenames.AdminImpersonate, []any{admin.ID}},
+	}
+
+	for _, r := range routes {
+		t.Run(r.name, func(t *testing.T) {
+			if r.method == http.MethodPost {
+				resp = s.post(routeURL(r.name, r.params...), nil)
+			} else {
+				resp = s.get(routeURL(r.name, r.params...))
+			}
+			assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		})
+	}
+
+	// Stop impersonating, after which the admin can manage their own account again.
+	resp = s.post(routeURL(routenames.AdminImpersonateStop), nil)
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	resp = s.get(routeURL(routenames.Settings))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// The impersonations are recorded and can be listed, but not changed, including by the admin who made them.
+	record, err := c.ORM.Impersonation.
+		Query().
+		Where(impersonation.AdminID(admin.ID)).
+		First(context.Background())
+	require.NoError(t, err)
+
+	list := routeURL(routenames.AdminEntityList("Impersonation"))
+	resp = s.get(list)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	for _, action := range []string{"add", fmt.Sprintf("%d/edit", record.ID), fmt.Sprintf("%d/delete", record.ID)} {
+		resp = s.get(fmt.Sprintf("%s/%s", list, action))
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		resp = s.post(fmt.Sprintf("%s/%s", list, action), url.Values{"user_id": []string{strconv.Itoa(admin.ID)}})
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	}
+
+	exists, err := c.ORM.Impersonation.
+		Query().
+		Where(
+			impersonation.ID(record.ID),
+			impersonation.UserID(usr.ID),
+		).
+		Exist(context.Background())
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = c.ORM.Permission.
+		Query().
+		Where(permission.Name(permissions.Entity("Impersonation", permissions.ActionEdit))).
+		Exist(context.Background())
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
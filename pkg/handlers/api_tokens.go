@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/form"
+	"github.com/mikestefanello/pagoda/pkg/log"
+	"github.com/mikestefanello/pagoda/pkg/middleware"
+	"github.com/mikestefanello/pagoda/pkg/msg"
+	"github.com/mikestefanello/pagoda/pkg/redirect"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/ui/forms"
+	"github.com/mikestefanello/pagoda/pkg/ui/models"
+	"github.com/mikestefanello/pagoda/pkg/ui/pages"
+)
+
+type APITokens struct {
+	auth *services.AuthClient
+}
+
+func init() {
+	Register(new(APITokens))
+}
+
+func (h *APITokens) Init(c *services.Container) error {
+	h.auth = c.Auth
+	return nil
+}
+
+func (h *APITokens) Routes(g *echo.Group) {
+	t := g.Group("/user/tokens",
+		middleware.RequireAuthentication,
+		middleware.RequireNoAPIToken,
+		middleware.RequireNoImpersonation,
+	)
+	t.GET("", h.Page).Name = routenames.APITokens
+	t.POST("", h.Submit).Name = routenames.APITokenCreate
+	t.POST("/:token/revoke", h.Revoke).Name = routenames.APITokenRevoke
+}
+
+func (h *APITokens) Page(ctx echo.Context) error {
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	tokens, err := h.auth.GetAPITokens(ctx, u.ID)
+	if err != nil {
+		return fail(err, "unable to load api tokens")
+	}
+
+	list := make(models.APITokens, len(tokens))
+	for i, t := range tokens {
+		list[i] = &models.APIToken{
+			ID:         t.ID,
+			Name:       t.Name,
+			Scopes:     t.Scopes,
+			CreatedAt:  t.CreatedAt,
+			LastUsedAt: t.LastUsedAt,
+			ExpiresAt:  t.ExpiresAt,
+		}
+	}
+
+	// Default to the most limited access.
+	f := form.Get[forms.APIToken](ctx)
+	if !f.IsSubmitted() {
+		f.Access = services.APITokenScopeRead
+		f.Expiration = "30"
+	}
+
+	return pages.APITokens(ctx, list, f)
+}
+
+func (h *APITokens) Submit(ctx echo.Context) error {
+	var input forms.APIToken
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.Page(ctx)
+	default:
+		return err
+	}
+
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	scopes := []string{services.APITokenScopeRead}
+	if input.Access == services.APITokenScopeWrite {
+		scopes = services.APITokenScopes
+	}
+
+	var expiresAt time.Time
+	if days, err := strconv.Atoi(input.Expiration); err == nil {
+		expiresAt = time.Now().AddDate(0, 0, days)
+	}
+
+	token, t, err := h.auth.CreateAPIToken(ctx, u.ID, input.Name, scopes, expiresAt)
+	if err != nil {
+		return fail(err, "unable to create api token")
+	}
+
+	log.Ctx(ctx).Info("api token created",
+		"user_id", u.ID,
+		"api_token_id", t.ID,
+	)
+
+	form.Clear(ctx)
+	return pages.APITokenCreated(ctx, token)
+}
+
+func (h *APITokens) Revoke(ctx echo.Context) error {
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	id, err := strconv.Atoi(ctx.Param("token"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid token ID")
+	}
+
+	err = h.auth.RevokeAPIToken(ctx, u.ID, id)
+	switch {
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound, "token not found")
+	case err != nil:
+		return fail(err, "unable to revoke api token")
+	}
+
+	log.Ctx(ctx).Info("api token revoked",
+		"user_id", u.ID,
+		"api_token_id", id,
+	)
+
+	msg.Success(ctx, "The token has been revoked.")
+	return redirect.New(ctx).
+		Route(routenames.APITokens).
+		Go()
+}
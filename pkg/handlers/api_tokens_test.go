@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPITokens__AccountManagement(t *testing.T) {
+	// Use an admin so routes that require a permission are not rejected for lacking it.
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	u, err = u.Update().SetAdmin(true).Save(context.Background())
+	require.NoError(t, err)
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+	token, _, err := c.Auth.CreateAPIToken(
+		ctx,
+		u.ID,
+		"test",
+		[]string{services.APITokenScopeRead, services.APITokenScopeWrite},
+		time.Time{},
+	)
+	require.NoError(t, err)
+
+	client := http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	routes := []struct {
+		method string
+		name   string
+		params []any
+	}{
+		{http.MethodGet, routenames.Logout, nil},
+		{http.MethodGet, routenames.Settings, nil},
+		{http.MethodPost, routenames.SettingsName, nil},
+		{http.MethodPost, routenames.SettingsEmail, nil},
+		{http.MethodPost, routenames.SettingsPassword, nil},
+		{http.MethodPost, routenames.SettingsDelete, nil},
+		{http.MethodGet, routenames.TwoFactor, nil},
+		{http.MethodPost, routenames.TwoFactorEnable, nil},
+		{http.MethodPost, routenames.TwoFactorDisable, nil},
+		{http.MethodGet, routenames.Sessions, nil},
+		{http.MethodPost, routenames.SessionRevoke, []any{"abc"}},
+		{http.MethodGet, routenames.APITokens, nil},
+		{http.MethodPost, routenames.APITokenCreate, nil},
+		{http.MethodPost, routenames.APITokenRevoke, []any{1}},
+		{http.MethodGet, routenames.Passkeys, nil},
+		{http.MethodGet, routenames.PasskeyRegister, nil},
+		{http.MethodPost, routenames.PasskeyCreate, nil},
+		{http.MethodPost, routenames.PasskeyDelete, []any{1}},
+		{http.MethodGet, routenames.Invitations, nil},
+		{http.MethodPost, routenames.InvitationCreate, nil},
+		{http.MethodPost, routenames.InvitationRevoke, []any{1}},
+	}
+
+	for _, route := range routes {
+		t.Run(route.name, func(t *testing.T) {
+			req, err := http.NewRequest(route.method, srv.URL+c.Web.Reverse(route.name, route.params...), nil)
+			require.NoError(t, err)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		})
+	}
+}
@@ -2,6 +2,9 @@ package handlers
 
 import (
 	"fmt"
+	"math"
+	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
@@ -20,16 +23,18 @@ import (
 	"github.com/mikestefanello/pagoda/pkg/tasks" // For EmailArgs
 	"github.com/mikestefanello/pagoda/pkg/ui/emails"
 	"github.com/mikestefanello/pagoda/pkg/ui/forms"
+	"github.com/mikestefanello/pagoda/pkg/ui/models"
 	"github.com/mikestefanello/pagoda/pkg/ui/pages"
 	"github.com/riverqueue/river" // For River client
 )
 
 type Auth struct {
-	config *config.Config
-	auth   *services.AuthClient
-	mail   *services.MailClient
-	orm    *ent.Client
-	River  *river.Client
+	config  *config.Config
+	auth    *services.AuthClient
+	mail    *services.MailClient
+	orm     *ent.Client
+	limiter *services.RateLimiter
+	River   *river.Client
 }
 
 func init() {
@@ -41,21 +46,66 @@ func (h *Auth) Init(c *services.Container) error {
 	h.orm = c.ORM
 	h.auth = c.Auth
 	h.mail = c.Mail
+	h.limiter = c.RateLimiter
 	h.River = c.River
 	return nil
 }
 
 func (h *Auth) Routes(g *echo.Group) {
-	g.GET("/logout", h.Logout, middleware.RequireAuthentication).Name = routenames.Logout
+	g.GET("/logout", h.Logout, middleware.RequireAuthentication, middleware.RequireNoAPIToken).Name = routenames.Logout
 	g.GET("/email/verify/:token", h.VerifyEmail).Name = routenames.VerifyEmail
+	g.GET("/email/verify", h.VerifyEmailNotice, middleware.RequireAuthentication).Name = routenames.VerifyEmailNotice
+	g.POST("/email/verify", h.ResendVerificationEmail,
+		middleware.RequireAuthentication,
+		middleware.RateLimit(h.limiter, "verify_email", h.config.RateLimit.VerifyEmail, middleware.RateLimitByUser),
+	).Name = routenames.VerifyEmailResend
+	g.GET("/user/unlock/:token", h.UnlockAccount).Name = routenames.UnlockAccount
+
+	passkeys := g.Group("/user/passkeys",
+		middleware.RequireAuthentication,
+		middleware.RequireNoAPIToken,
+		middleware.RequireNoImpersonation,
+	)
+	passkeys.GET("", h.PasskeysPage).Name = routenames.Passkeys
+	passkeys.GET("/register", h.PasskeyRegisterOptions).Name = routenames.PasskeyRegister
+	passkeys.POST("", h.PasskeyRegisterSubmit).Name = routenames.PasskeyCreate
+	passkeys.POST("/:passkey/delete", h.PasskeyDelete).Name = routenames.PasskeyDelete
 
 	noAuth := g.Group("/user", middleware.RequireNoAuthentication)
 	noAuth.GET("/login", h.LoginPage).Name = routenames.Login
-	noAuth.POST("/login", h.LoginSubmit).Name = routenames.LoginSubmit
+	noAuth.POST("/login", h.LoginSubmit,
+		middleware.RateLimit(h.limiter, "login_ip", h.config.RateLimit.Login, middleware.RateLimitByIP),
+		middleware.RateLimit(h.limiter, "login_email", h.config.RateLimit.Login, middleware.RateLimitByFormField("email")),
+	).Name = routenames.LoginSubmit
+
+	verify := noAuth.Group("/login/verify", middleware.LoadPendingUser(h.auth))
+	verify.GET("", h.LoginVerifyPage).Name = routenames.LoginVerify
+	verify.POST("", h.LoginVerifySubmit,
+		middleware.RateLimit(h.limiter, "login_verify", h.config.RateLimit.Login, middleware.RateLimitByIP),
+	).Name = routenames.LoginVerifySubmit
+	noAuth.GET("/login/link", h.MagicLinkPage).Name = routenames.MagicLink
+	noAuth.POST("/login/link", h.MagicLinkSubmit,
+		middleware.RateLimit(h.limiter, "magic_link_ip", h.config.RateLimit.MagicLink, middleware.RateLimitByIP),
+		middleware.RateLimit(h.limiter, "magic_link_email", h.config.RateLimit.MagicLink, middleware.RateLimitByFormField("email")),
+	).Name = routenames.MagicLinkSubmit
+	noAuth.GET("/login/link/:token", h.MagicLinkLogin).Name = routenames.MagicLinkLogin
+	noAuth.GET("/login/passkey", h.PasskeyLoginOptions).Name = routenames.PasskeyLogin
+	noAuth.POST("/login/passkey", h.PasskeyLoginSubmit,
+		middleware.RateLimit(h.limiter, "passkey_login", h.config.RateLimit.Login, middleware.RateLimitByIP),
+	).Name = routenames.PasskeyLoginSubmit
+	noAuth.GET("/oauth/:provider", h.OAuthLogin).Name = routenames.OAuthLogin
+	noAuth.GET("/oauth/:provider/callback", h.OAuthCallback,
+		middleware.RateLimit(h.limiter, "oauth_callback", h.config.RateLimit.Login, middleware.RateLimitByIP),
+	).Name = routenames.OAuthCallback
 	noAuth.GET("/register", h.RegisterPage).Name = routenames.Register
-	noAuth.POST("/register", h.RegisterSubmit).Name = routenames.RegisterSubmit
+	noAuth.POST("/register", h.RegisterSubmit,
+		middleware.RateLimit(h.limiter, "register", h.config.RateLimit.Register, middleware.RateLimitByIP),
+	).Name = routenames.RegisterSubmit
 	noAuth.GET("/password", h.ForgotPasswordPage).Name = routenames.ForgotPassword
-	noAuth.POST("/password", h.ForgotPasswordSubmit).Name = routenames.ForgotPasswordSubmit
+	noAuth.POST("/password", h.ForgotPasswordSubmit,
+		middleware.RateLimit(h.limiter, "forgot_password_ip", h.config.RateLimit.ForgotPassword, middleware.RateLimitByIP),
+		middleware.RateLimit(h.limiter, "forgot_password_email", h.config.RateLimit.ForgotPassword, middleware.RateLimitByFormField("email")),
+	).Name = routenames.ForgotPasswordSubmit
 
 	resetGroup := noAuth.Group("/password/reset",
 		middleware.LoadUser(h.orm),
@@ -129,7 +179,16 @@ func (h *Auth) ForgotPasswordSubmit(ctx echo.Context) error {
 }
 
 func (h *Auth) LoginPage(ctx echo.Context) error {
-	return pages.Login(ctx, form.Get[forms.Login](ctx))
+	providers := h.auth.GetOAuthProviders()
+	m := make(models.OAuthProviders, len(providers))
+	for i, p := range providers {
+		m[i] = &models.OAuthProvider{
+			Name:  p.Name,
+			Label: p.Label,
+		}
+	}
+
+	return pages.Login(ctx, form.Get[forms.Login](ctx), form.Get[forms.PasskeyLogin](ctx), m)
 }
 
 func (h *Auth) LoginSubmit(ctx echo.Context) error {
@@ -153,31 +212,548 @@ func (h *Auth) LoginSubmit(ctx echo.Context) error {
 	}
 
 	// Attempt to load the user.
+	email := strings.ToLower(input.Email)
 	u, err := h.orm.User.
 		Query().
-		Where(user.Email(strings.ToLower(input.Email))).
+		Where(user.Email(email)).
 		Only(ctx.Request().Context())
 
 	switch err.(type) {
 	case *ent.NotFoundError:
-		return authFailed()
 	case nil:
 	default:
 		return fail(err, "error querying user during login")
 	}
 
+	// Check if the account is locked or if previous failed attempts require waiting.
+	if err = h.auth.CheckLoginAllowed(ctx, email, u); err != nil {
+		return h.loginNotAllowed(ctx, err, h.LoginPage)
+	}
+
 	// Check if the password is correct.
-	err = h.auth.CheckPassword(input.Password, u.Password)
-	if err != nil {
+	if u == nil || h.auth.CheckPassword(input.Password, u.Password) != nil {
+		if err = h.loginFailed(ctx, email, u); err != nil {
+			return err
+		}
 		return authFailed()
 	}
 
-	// Log the user in.
-	err = h.auth.Login(ctx, u.ID)
+	if err = h.auth.CheckVerified(u); err != nil {
+		return h.loginUnverified(ctx, u, h.LoginPage)
+	}
+
+	// Users with two-factor authentication must enter their code before they are logged in.
+	if u.TotpSecret != "" {
+		if err = h.auth.LoginPending(ctx, u.ID, input.Remember); err != nil {
+			return fail(err, "unable to set login pending second factor")
+		}
+
+		return redirect.New(ctx).
+			Route(routenames.LoginVerify).
+			Go()
+	}
+
+	return h.completeLogin(ctx, u, input.Remember)
+}
+
+func (h *Auth) LoginVerifyPage(ctx echo.Context) error {
+	return pages.LoginVerify(ctx, form.Get[forms.LoginVerify](ctx))
+}
+
+func (h *Auth) LoginVerifySubmit(ctx echo.Context) error {
+	var input forms.LoginVerify
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.LoginVerifyPage(ctx)
+	default:
+		return err
+	}
+
+	u := ctx.Get(context.UserKey).(*ent.User)
+
+	// Failed codes count towards the lockout of the account, just like failed passwords.
+	if err = h.auth.CheckLoginAllowed(ctx, u.Email, u); err != nil {
+		return h.loginNotAllowed(ctx, err, h.LoginVerifyPage)
+	}
+
+	// Check the code, which can either be from the authenticator app or a recovery code.
+	valid, err := h.auth.ValidateTOTP(ctx, u, input.Code)
+	if err != nil {
+		return fail(err, "unable to validate TOTP code")
+	}
+
+	if !valid {
+		valid, err = h.auth.UseRecoveryCode(ctx, u.ID, input.Code)
+		if err != nil {
+			return fail(err, "unable to check recovery codes")
+		}
+
+		if valid {
+			log.Ctx(ctx).Info("recovery code used",
+				"user_id", u.ID,
+			)
+		}
+	}
+
+	if !valid {
+		if err = h.loginFailed(ctx, u.Email, u); err != nil {
+			return err
+		}
+		input.SetFieldError("Code", "The code is invalid. Please try again.")
+		return h.LoginVerifyPage(ctx)
+	}
+
+	return h.completeLogin(ctx, u, h.auth.PendingRemember(ctx))
+}
+
+func (h *Auth) MagicLinkPage(ctx echo.Context) error {
+	return pages.MagicLink(ctx, form.Get[forms.MagicLink](ctx))
+}
+
+func (h *Auth) MagicLinkSubmit(ctx echo.Context) error {
+	var input forms.MagicLink
+
+	succeed := func() error {
+		form.Clear(ctx)
+		msg.Success(ctx, "An email containing a link to log in will be sent to this address if it exists in our system.")
+		return h.MagicLinkPage(ctx)
+	}
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.MagicLinkPage(ctx)
+	default:
+		return err
+	}
+
+	// Attempt to load the user.
+	u, err := h.orm.User.
+		Query().
+		Where(user.Email(strings.ToLower(input.Email))).
+		Only(ctx.Request().Context())
+
+	switch err.(type) {
+	case *ent.NotFoundError:
+		return succeed()
+	case nil:
+	default:
+		return fail(err, "error querying user during magic link request")
+	}
+
+	// Generate the token.
+	token, err := h.auth.GenerateMagicLinkToken(ctx, u.ID)
+	if err != nil {
+		return fail(err, "error generating magic link token")
+	}
+
+	log.Ctx(ctx).Info("generated magic link token",
+		"user_id", u.ID,
+	)
+
+	// Email the user.
+	url := ctx.Echo().Reverse(routenames.MagicLinkLogin, token)
+	err = h.mail.
+		Compose().
+		To(u.Email).
+		Subject("Your login link").
+		Body(fmt.Sprintf("Go here to log in, which can only be done once: %s", h.config.App.Host+url)).
+		Send(ctx)
+
 	if err != nil {
+		return fail(err, "error sending magic link email")
+	}
+
+	return succeed()
+}
+
+func (h *Auth) MagicLinkLogin(ctx echo.Context) error {
+	userID, err := h.auth.UseMagicLinkToken(ctx, ctx.Param("token"))
+
+	switch err.(type) {
+	case nil:
+	case services.InvalidMagicLinkError:
+		msg.Warning(ctx, "The link is either invalid, has expired or has already been used.")
+		return redirect.New(ctx).
+			Route(routenames.Login).
+			Go()
+	default:
+		return fail(err, "unable to use magic link token")
+	}
+
+	u, err := h.orm.User.Get(ctx.Request().Context(), userID)
+	if err != nil {
+		return fail(err, "unable to load magic link user")
+	}
+
+	log.Ctx(ctx).Info("user authenticated with magic link",
+		"user_id", u.ID,
+	)
+
+	if err = h.auth.CheckLoginAllowed(ctx, u.Email, u); err != nil {
+		return h.loginNotAllowed(ctx, err, h.loginRedirect)
+	}
+
+	// Links are only sent to the email address of the user, so using one verifies it.
+	if !u.Verified {
+		u, err = u.
+			Update().
+			SetVerified(true).
+			Save(ctx.Request().Context())
+
+		if err != nil {
+			return fail(err, "failed to set user as verified")
+		}
+	}
+
+	// The link does not replace two-factor authentication.
+	if u.TotpSecret != "" {
+		if err = h.auth.LoginPending(ctx, u.ID, false); err != nil {
+			return fail(err, "unable to set login pending second factor")
+		}
+
+		return redirect.New(ctx).
+			Route(routenames.LoginVerify).
+			Go()
+	}
+
+	return h.completeLogin(ctx, u, false)
+}
+
+// PasskeyLoginOptions starts a passkey login and responds with the options for the browser to pass to the
+// authenticator. The response of the authenticator is then submitted to PasskeyLoginSubmit.
+func (h *Auth) PasskeyLoginOptions(ctx echo.Context) error {
+	assertion, err := h.auth.BeginPasskeyLogin(ctx)
+	if err != nil {
+		return fail(err, "unable to start passkey login")
+	}
+
+	return ctx.JSON(http.StatusOK, assertion)
+}
+
+func (h *Auth) PasskeyLoginSubmit(ctx echo.Context) error {
+	var input forms.PasskeyLogin
+
+	passkeyFailed := func(err error) error {
+		log.Ctx(ctx).Warn("passkey login failed",
+			"error", err,
+		)
+		msg.Error(ctx, "Unable to log in with your passkey. Please try again.")
+		return redirect.New(ctx).
+			Route(routenames.Login).
+			Go()
+	}
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return passkeyFailed(err)
+	default:
+		return err
+	}
+
+	u, err := h.auth.FinishPasskeyLogin(ctx, input.Credential)
+
+	switch err.(type) {
+	case nil:
+	case services.PasskeyError:
+		return passkeyFailed(err)
+	default:
+		return fail(err, "unable to finish passkey login")
+	}
+
+	log.Ctx(ctx).Info("user authenticated with passkey",
+		"user_id", u.ID,
+	)
+
+	if err = h.auth.CheckLoginAllowed(ctx, u.Email, u); err != nil {
+		return h.loginNotAllowed(ctx, err, h.loginRedirect)
+	}
+
+	if err = h.auth.CheckVerified(u); err != nil {
+		return h.loginUnverified(ctx, u, h.loginRedirect)
+	}
+
+	// Passkeys verify the user, such as with a fingerprint or PIN, so they replace two-factor authentication.
+	return h.completeLogin(ctx, u, false)
+}
+
+func (h *Auth) PasskeysPage(ctx echo.Context) error {
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	passkeys, err := h.auth.GetPasskeys(ctx, u.ID)
+	if err != nil {
+		return fail(err, "unable to load passkeys")
+	}
+
+	list := make(models.Passkeys, len(passkeys))
+	for i, p := range passkeys {
+		list[i] = &models.Passkey{
+			ID:         p.ID,
+			Name:       p.Name,
+			CreatedAt:  p.CreatedAt,
+			LastUsedAt: p.LastUsedAt,
+		}
+	}
+
+	return pages.Passkeys(ctx, list, form.Get[forms.Passkey](ctx))
+}
+
+// PasskeyRegisterOptions starts registering a passkey for the authenticated user and responds with the options for
+// the browser to pass to the authenticator. The response of the authenticator is then submitted to
+// PasskeyRegisterSubmit.
+func (h *Auth) PasskeyRegisterOptions(ctx echo.Context) error {
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	creation, err := h.auth.BeginPasskeyRegistration(ctx, u)
+	if err != nil {
+		return fail(err, "unable to start passkey registration")
+	}
+
+	return ctx.JSON(http.StatusOK, creation)
+}
+
+func (h *Auth) PasskeyRegisterSubmit(ctx echo.Context) error {
+	var input forms.Passkey
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.PasskeysPage(ctx)
+	default:
+		return err
+	}
+
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	p, err := h.auth.FinishPasskeyRegistration(ctx, u, input.Name, input.Credential)
+
+	switch err.(type) {
+	case nil:
+	case services.PasskeyError:
+		log.Ctx(ctx).Warn("passkey registration failed",
+			"user_id", u.ID,
+			"error", err,
+		)
+		msg.Error(ctx, "Unable to add your passkey. Please try again.")
+		return h.PasskeysPage(ctx)
+	default:
+		return fail(err, "unable to register passkey")
+	}
+
+	log.Ctx(ctx).Info("passkey registered",
+		"user_id", u.ID,
+		"passkey_id", p.ID,
+	)
+
+	form.Clear(ctx)
+	msg.Success(ctx, "Your passkey has been added. You can now use it to log in.")
+	return redirect.New(ctx).
+		Route(routenames.Passkeys).
+		Go()
+}
+
+func (h *Auth) PasskeyDelete(ctx echo.Context) error {
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	id, err := strconv.Atoi(ctx.Param("passkey"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid passkey ID")
+	}
+
+	err = h.auth.DeletePasskey(ctx, u.ID, id)
+	switch {
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound, "passkey not found")
+	case err != nil:
+		return fail(err, "unable to delete passkey")
+	}
+
+	log.Ctx(ctx).Info("passkey deleted",
+		"user_id", u.ID,
+		"passkey_id", id,
+	)
+
+	msg.Success(ctx, "The passkey has been removed.")
+	return redirect.New(ctx).
+		Route(routenames.Passkeys).
+		Go()
+}
+
+func (h *Auth) OAuthLogin(ctx echo.Context) error {
+	p, ok := h.auth.GetOAuthProvider(ctx.Param("provider"))
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotFound, "provider not found")
+	}
+
+	url, err := h.auth.OAuthAuthCodeURL(ctx, p, h.oauthRedirectURL(ctx, p))
+	if err != nil {
+		return fail(err, "unable to start oauth login")
+	}
+
+	return redirect.New(ctx).
+		URL(url).
+		StatusCode(http.StatusFound).
+		Go()
+}
+
+func (h *Auth) OAuthCallback(ctx echo.Context) error {
+	p, ok := h.auth.GetOAuthProvider(ctx.Param("provider"))
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotFound, "provider not found")
+	}
+
+	oauthFailed := func(err error) error {
+		log.Ctx(ctx).Warn("oauth login failed",
+			"provider", p.Name,
+			"error", err,
+		)
+		msg.Error(ctx, fmt.Sprintf("Unable to log in with %s. Please try again.", p.Label))
+		return redirect.New(ctx).
+			Route(routenames.Login).
+			Go()
+	}
+
+	identity, err := h.auth.OAuthExchange(ctx, p, h.oauthRedirectURL(ctx, p))
+	if err != nil {
+		return oauthFailed(err)
+	}
+
+	// Load the user the identity belongs to, linking or creating one if this is the first time it is used.
+	u, err := h.auth.GetOAuthUser(ctx, identity)
+
+	switch err.(type) {
+	case nil:
+	case services.OAuthAccountExistsError:
+		msg.Warning(ctx, fmt.Sprintf("A user with this email address already exists, but %s has not verified "+
+			"that it belongs to you. Please log in with your password.", p.Label))
+		return redirect.New(ctx).
+			Route(routenames.Login).
+			Go()
+	case services.OAuthError:
+		return oauthFailed(err)
+	case services.InvitationRequiredError:
+		msg.Warning(ctx, "Registration is by invitation only. Please ask for an invitation to create an account.")
+		return redirect.New(ctx).
+			Route(routenames.Login).
+			Go()
+	default:
+		return fail(err, "unable to load oauth user")
+	}
+
+	log.Ctx(ctx).Info("user authenticated with oauth",
+		"provider", p.Name,
+		"user_id", u.ID,
+	)
+
+	if err = h.auth.CheckLoginAllowed(ctx, u.Email, u); err != nil {
+		return h.loginNotAllowed(ctx, err, h.loginRedirect)
+	}
+
+	if err = h.auth.CheckVerified(u); err != nil {
+		return h.loginUnverified(ctx, u, h.loginRedirect)
+	}
+
+	// The provider does not replace two-factor authentication.
+	if u.TotpSecret != "" {
+		if err = h.auth.LoginPending(ctx, u.ID, false); err != nil {
+			return fail(err, "unable to set login pending second factor")
+		}
+
+		return redirect.New(ctx).
+			Route(routenames.LoginVerify).
+			Go()
+	}
+
+	return h.completeLogin(ctx, u, false)
+}
+
+// oauthRedirectURL returns the absolute URL that a given OAuth provider sends users back to.
+func (h *Auth) oauthRedirectURL(ctx echo.Context, p *services.OAuthProvider) string {
+	return h.config.App.Host + ctx.Echo().Reverse(routenames.OAuthCallback, p.Name)
+}
+
+// loginNotAllowed renders a given page with a message explaining why a login attempt was not allowed.
+func (h *Auth) loginNotAllowed(ctx echo.Context, err error, page echo.HandlerFunc) error {
+	switch e := err.(type) {
+	case services.AccountLockedError:
+		msg.Error(ctx, "This account has been temporarily locked due to too many failed login attempts. "+
+			"Check your email for a link to unlock it, or try again later.")
+	case services.LoginThrottledError:
+		msg.Error(ctx, fmt.Sprintf("Too many failed login attempts. Please wait %d seconds and try again.",
+			int(math.Ceil(e.RetryAfter.Seconds()))))
+	default:
+		return fail(err, "error checking login attempts")
+	}
+
+	return page(ctx)
+}
+
+// loginRedirect redirects to the login page, for login paths which do not have a page of their own.
+func (h *Auth) loginRedirect(ctx echo.Context) error {
+	return redirect.New(ctx).
+		Route(routenames.Login).
+		Go()
+}
+
+// loginFailed records a failed login attempt, and emails the user a link to unlock their account if it is now
+// locked.
+func (h *Auth) loginFailed(ctx echo.Context, email string, u *ent.User) error {
+	locked, err := h.auth.RecordLoginAttempt(ctx, email, u, false)
+	if err != nil {
+		return fail(err, "error recording failed login attempt")
+	}
+
+	if locked != nil {
+		log.Ctx(ctx).Warn("user locked after failed login attempts",
+			"user_id", locked.ID,
+		)
+		h.sendUnlockEmail(ctx, locked)
+	}
+
+	return nil
+}
+
+// loginUnverified handles a user who cannot log in until they verify their email address. Since they cannot request
+// a new verification email without logging in, one is sent to them.
+func (h *Auth) loginUnverified(ctx echo.Context, u *ent.User, page echo.HandlerFunc) error {
+	log.Ctx(ctx).Info("login blocked for unverified user",
+		"user_id", u.ID,
+	)
+
+	h.sendVerificationEmail(ctx, u)
+	msg.Warning(ctx, fmt.Sprintf("Your email address is still pending verification, which is required before you "+
+		"can log in. A new link to verify it has been sent to %s.", u.Email))
+	return page(ctx)
+}
+
+// completeLogin records a successful login attempt and logs the user in, remembering them if they asked to be.
+func (h *Auth) completeLogin(ctx echo.Context, u *ent.User, remember bool) error {
+	if _, err := h.auth.RecordLoginAttempt(ctx, u.Email, u, true); err != nil {
+		return fail(err, "error recording login attempt")
+	}
+
+	// Log the user in.
+	if err := h.auth.Login(ctx, u.ID); err != nil {
 		return fail(err, "unable to log in user")
 	}
 
+	if remember {
+		if err := h.auth.Remember(ctx, u.ID); err != nil {
+			return fail(err, "unable to remember user")
+		}
+	}
+
 	msg.Success(ctx, fmt.Sprintf("Welcome back, %s. You are now logged in.", u.Name))
 
 	return redirect.New(ctx).
@@ -185,6 +761,58 @@ func (h *Auth) LoginSubmit(ctx echo.Context) error {
 		Go()
 }
 
+func (h *Auth) sendUnlockEmail(ctx echo.Context, usr *ent.User) {
+	token, err := h.auth.GenerateUnlockToken(usr)
+	if err != nil {
+		log.Ctx(ctx).Error("unable to generate unlock token",
+			"user_id", usr.ID,
+			"error", err,
+		)
+		return
+	}
+
+	url := ctx.Echo().Reverse(routenames.UnlockAccount, token)
+	err = h.mail.
+		Compose().
+		To(usr.Email).
+		Subject("Your account has been locked").
+		Body(fmt.Sprintf(
+			"Your account was locked due to too many failed login attempts. If this was you, go here to unlock it: %s",
+			h.config.App.Host+url,
+		)).
+		Send(ctx)
+
+	if err != nil {
+		log.Ctx(ctx).Error("unable to send unlock email",
+			"user_id", usr.ID,
+			"error", err,
+		)
+	}
+}
+
+func (h *Auth) UnlockAccount(ctx echo.Context) error {
+	userID, err := h.auth.ValidateUnlockToken(ctx.Param("token"))
+	if err != nil {
+		msg.Warning(ctx, "The link is either invalid or has expired.")
+		return redirect.New(ctx).
+			Route(routenames.Login).
+			Go()
+	}
+
+	if err = h.auth.UnlockUser(ctx, userID); err != nil {
+		return fail(err, "unable to unlock user")
+	}
+
+	log.Ctx(ctx).Info("user unlocked",
+		"user_id", userID,
+	)
+
+	msg.Success(ctx, "Your account has been unlocked. You can now log in.")
+	return redirect.New(ctx).
+		Route(routenames.Login).
+		Go()
+}
+
 func (h *Auth) Logout(ctx echo.Context) error {
 	if err := h.auth.Logout(ctx); err == nil {
 		msg.Success(ctx, "You have been logged out successfully.")
@@ -197,7 +825,30 @@ func (h *Auth) Logout(ctx echo.Context) error {
 }
 
 func (h *Auth) RegisterPage(ctx echo.Context) error {
-	return pages.Register(ctx, form.Get[forms.Register](ctx))
+	f := form.Get[forms.Register](ctx)
+	if !f.IsSubmitted() {
+		f.Invitation = ctx.QueryParam("invite")
+	}
+
+	// Invitations fill in the email address they were sent to, which cannot be changed.
+	if f.Invitation != "" {
+		inv, err := h.auth.GetInvitation(ctx, f.Invitation)
+		switch err.(type) {
+		case nil:
+			f.Email = inv.Email
+		case services.InvalidInvitationError:
+			msg.Warning(ctx, "This invitation is invalid, has expired or has already been accepted.")
+			f.Invitation = ""
+		default:
+			return fail(err, "unable to load invitation")
+		}
+	}
+
+	if f.Invitation == "" && h.config.App.Registration.InviteOnly {
+		return pages.RegisterInviteOnly(ctx)
+	}
+
+	return pages.Register(ctx, f)
 }
 
 func (h *Auth) RegisterSubmit(ctx echo.Context) error {
@@ -213,13 +864,21 @@ func (h *Auth) RegisterSubmit(ctx echo.Context) error {
 		return err
 	}
 
-	// Attempt creating the user.
-	u, err := h.orm.User.
-		Create().
-		SetName(input.Name).
-		SetEmail(input.Email).
-		SetPassword(input.Password).
-		Save(ctx.Request().Context())
+	// Attempt creating the user, which requires an invitation if registration is invite-only.
+	var u *ent.User
+	if input.Invitation != "" || h.config.App.Registration.InviteOnly {
+		var inv *ent.Invitation
+		if inv, err = h.auth.GetInvitation(ctx, input.Invitation); err == nil {
+			u, err = h.auth.AcceptInvitation(ctx, inv, input.Name, input.Password)
+		}
+	} else {
+		u, err = h.orm.User.
+			Create().
+			SetName(input.Name).
+			SetEmail(input.Email).
+			SetPassword(input.Password).
+			Save(ctx.Request().Context())
+	}
 
 	switch err.(type) {
 	case nil:
@@ -227,6 +886,11 @@ func (h *Auth) RegisterSubmit(ctx echo.Context) error {
 			"user_name", u.Name,
 			"user_id", u.ID,
 		)
+	case services.InvalidInvitationError:
+		msg.Warning(ctx, "This invitation is invalid, has expired or has already been accepted.")
+		return redirect.New(ctx).
+			Route(routenames.Register).
+			Go()
 	case *ent.ConstraintError:
 		msg.Warning(ctx, "A user with this email address already exists. Please log in.")
 		return redirect.New(ctx).
@@ -251,14 +915,19 @@ func (h *Auth) RegisterSubmit(ctx echo.Context) error {
 
 	msg.Success(ctx, "Your account has been created. You are now logged in.")
 
-	// Send the verification email.
-	h.sendVerificationEmail(ctx, u)
+	// Send the verification email, unless the user was invited, since the invitation was sent to their email address.
+	if !u.Verified {
+		h.sendVerificationEmail(ctx, u)
+		msg.Info(ctx, "A link to verify your email address will be sent to you shortly.")
+	}
 
 	return redirect.New(ctx).
 		Route(routenames.Home).
 		Go()
 }
 
+// sendVerificationEmail sends a given user an email containing a link to verify their email address.
+// Callers are responsible for letting the user know that it was sent.
 func (h *Auth) sendVerificationEmail(ctx echo.Context, usr *ent.User) {
 	// Generate a token.
 	token, err := h.auth.GenerateEmailVerificationToken(usr.Email)
@@ -270,21 +939,21 @@ func (h *Auth) sendVerificationEmail(ctx echo.Context, usr *ent.User) {
 		return
 	}
 
-	// Send the email.
-	// Instead of sending directly, we enqueue a task.
-	emailBodyComponent := emails.ConfirmEmailAddress(ctx, usr.Name, token) // Assuming this returns a string or can be rendered to string.
-	// If Component returns a gomponents.Node, you'll need to render it to a string first.
-	// For simplicity, let's assume we can get a string body easily.
-	// This might require adjusting how emails.ConfirmEmailAddress works or adding a helper.
-	// For now, we'll use a placeholder for the body.
-	// In a real app, you'd render the component to an HTML string.
-	emailBodyHTML := fmt.Sprintf("Please verify your email using this token: %s (This is a placeholder - implement HTML rendering)", token)
+	// Render the email, since it is sent by a task rather than directly.
+	var body strings.Builder
+	if err = emails.ConfirmEmailAddress(ctx, usr.Name, token).Render(&body); err != nil {
+		log.Ctx(ctx).Error("unable to render email verification email",
+			"user_id", usr.ID,
+			"error", err,
+		)
+		return
+	}
 
 	emailArgs := tasks.EmailArgs{
 		UserID:       usr.ID,
 		EmailAddress: usr.Email,
 		Subject:      "Confirm your email address",
-		Body:         emailBodyHTML, // This should be the rendered HTML of your email component
+		Body:         body.String(),
 	}
 
 	_, err = h.River.Insert(ctx.Request().Context(), emailArgs, nil)
@@ -293,12 +962,7 @@ func (h *Auth) sendVerificationEmail(ctx echo.Context, usr *ent.User) {
 			"user_id", usr.ID,
 			"error", err,
 		)
-		// Not returning an error to the user here, as registration succeeded.
-		// The message below will still be shown.
-		// Potentially add a different message if enqueueing fails critically.
 	}
-
-	msg.Info(ctx, "Your account has been created. A verification email will be sent to you shortly.")
 }
 
 func (h *Auth) ResetPasswordPage(ctx echo.Context) error {
@@ -306,7 +970,13 @@ func (h *Auth) ResetPasswordPage(ctx echo.Context) error {
 }
 
 func (h *Auth) ResetPasswordSubmit(ctx echo.Context) error {
-	var input forms.ResetPassword
+	// Get the requesting user.
+	usr := ctx.Get(context.UserKey).(*ent.User)
+
+	input := forms.ResetPassword{
+		Email: usr.Email,
+		Name:  usr.Name,
+	}
 
 	err := form.Submit(ctx, &input)
 
@@ -318,9 +988,6 @@ func (h *Auth) ResetPasswordSubmit(ctx echo.Context) error {
 		return err
 	}
 
-	// Get the requesting user.
-	usr := ctx.Get(context.UserKey).(*ent.User)
-
 	// Update the user.
 	_, err = usr.
 		Update().
@@ -337,12 +1004,44 @@ func (h *Auth) ResetPasswordSubmit(ctx echo.Context) error {
 		return fail(err, "unable to delete password tokens")
 	}
 
+	// Log out all sessions of this user, since the password may have been reset because the account was compromised.
+	err = h.auth.RevokeSessions(ctx, usr.ID)
+	if err != nil {
+		return fail(err, "unable to revoke sessions")
+	}
+
 	msg.Success(ctx, "Your password has been updated.")
 	return redirect.New(ctx).
 		Route(routenames.Login).
 		Go()
 }
 
+func (h *Auth) VerifyEmailNotice(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if usr.Verified {
+		msg.Info(ctx, "Your email address has already been verified.")
+		return redirect.New(ctx).
+			Route(routenames.Home).
+			Go()
+	}
+
+	return pages.VerifyEmailNotice(ctx)
+}
+
+func (h *Auth) ResendVerificationEmail(ctx echo.Context) error {
+	usr := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if !usr.Verified {
+		h.sendVerificationEmail(ctx, usr)
+		msg.Success(ctx, fmt.Sprintf("A link to verify your email address has been sent to %s.", usr.Email))
+	}
+
+	return redirect.New(ctx).
+		Route(routenames.VerifyEmailNotice).
+		Go()
+}
+
 func (h *Auth) VerifyEmail(ctx echo.Context) error {
 	var usr *ent.User
 
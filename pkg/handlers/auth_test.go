@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeOAuthProvider starts a local OAuth2 provider which approves every login as the same user.
+func newFakeOAuthProvider() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"sub":            "fake",
+			"email":          "oauth-fake@localhost.localhost",
+			"email_verified": true,
+			"name":           "Fake User",
+		})
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestAuth__OAuthCallback(t *testing.T) {
+	newClient := func() http.Client {
+		jar, err := cookiejar.New(nil)
+		require.NoError(t, err)
+		return http.Client{
+			Jar: jar,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+	}
+
+	// Start the login, which sends the user to the provider.
+	s := session{t: t, client: newClient()}
+	resp := s.get(routeURL(routenames.OAuthLogin, "fake"))
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	location, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+
+	// The provider sends the user back from another site, so browsers only send the cookies which are not strict.
+	callback := session{t: t, client: newClient()}
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	var cookies []*http.Cookie
+	for _, cookie := range resp.Cookies() {
+		if cookie.SameSite != http.SameSiteStrictMode {
+			cookies = append(cookies, cookie)
+		}
+	}
+	require.NotEmpty(t, cookies)
+	callback.client.Jar.SetCookies(u, cookies)
+
+	query := url.Values{
+		"code":  {"abc"},
+		"state": {location.Query().Get("state")},
+	}
+	resp = callback.get(routeURL(routenames.OAuthCallback, "fake") + "?" + query.Encode())
+	assert.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+	assert.NotEqual(t, c.Web.Reverse(routenames.Login), resp.Header.Get("Location"))
+
+	// The user is now logged in.
+	resp = callback.get(routeURL(routenames.Settings))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// The login cannot be completed again, even with the same cookies.
+	replay := session{t: t, client: newClient()}
+	replay.client.Jar.SetCookies(u, cookies)
+	resp = replay.get(routeURL(routenames.OAuthCallback, "fake") + "?" + query.Encode())
+	assert.Equal(t, c.Web.Reverse(routenames.Login), resp.Header.Get("Location"))
+}
+
+func TestAuth__MagicLinkLocked(t *testing.T) {
+	u := createVerifiedUser(t, false)
+	u, err := u.Update().SetLockedUntil(time.Now().Add(time.Hour)).Save(context.Background())
+	require.NoError(t, err)
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+	token, err := c.Auth.GenerateMagicLinkToken(ctx, u.ID)
+	require.NoError(t, err)
+
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	s := session{
+		t: t,
+		client: http.Client{
+			Jar: jar,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+
+	// Locked users cannot log in with a magic link.
+	resp := s.get(routeURL(routenames.MagicLinkLogin, token))
+	assert.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+	assert.Equal(t, c.Web.Reverse(routenames.Login), resp.Header.Get("Location"))
+
+	resp = s.get(routeURL(routenames.Settings))
+	assert.NotEqual(t, http.StatusOK, resp.StatusCode)
+}
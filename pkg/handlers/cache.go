@@ -34,15 +34,14 @@ func (h *Cache) Page(ctx echo.Context) error {
 	f := form.Get[forms.Cache](ctx)
 
 	// Fetch the value from the cache.
-	value, err := h.cache.
-		Get().
+	value, err := services.Cached[string](h.cache).
 		Key("page_cache_example").
 		Fetch(ctx.Request().Context())
 
 	// Store the value in the form, so it can be rendered, if found.
 	switch {
 	case err == nil:
-		f.CurrentValue = value.(string)
+		f.CurrentValue = value
 	case errors.Is(err, services.ErrCacheMiss):
 	default:
 		return fail(err, "failed to fetch from cache")
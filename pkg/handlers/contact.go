@@ -5,7 +5,9 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/config"
 	"github.com/mikestefanello/pagoda/pkg/form"
+	"github.com/mikestefanello/pagoda/pkg/middleware"
 	"github.com/mikestefanello/pagoda/pkg/routenames"
 	"github.com/mikestefanello/pagoda/pkg/services"
 	"github.com/mikestefanello/pagoda/pkg/ui/forms"
@@ -13,7 +15,9 @@ import (
 )
 
 type Contact struct {
-	mail *services.MailClient
+	mail    *services.MailClient
+	limiter *services.RateLimiter
+	config  *config.Config
 }
 
 func init() {
@@ -22,12 +26,16 @@ func init() {
 
 func (h *Contact) Init(c *services.Container) error {
 	h.mail = c.Mail
+	h.limiter = c.RateLimiter
+	h.config = c.Config
 	return nil
 }
 
 func (h *Contact) Routes(g *echo.Group) {
 	g.GET("/contact", h.Page).Name = routenames.Contact
-	g.POST("/contact", h.Submit).Name = routenames.ContactSubmit
+	g.POST("/contact", h.Submit,
+		middleware.RateLimit(h.limiter, "contact", h.config.RateLimit.Contact, middleware.RateLimitByIP),
+	).Name = routenames.ContactSubmit
 }
 
 func (h *Contact) Page(ctx echo.Context) error {
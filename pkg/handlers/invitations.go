@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	goctx "context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/form"
+	"github.com/mikestefanello/pagoda/pkg/log"
+	"github.com/mikestefanello/pagoda/pkg/middleware"
+	"github.com/mikestefanello/pagoda/pkg/msg"
+	"github.com/mikestefanello/pagoda/pkg/permissions"
+	"github.com/mikestefanello/pagoda/pkg/redirect"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/ui/forms"
+	"github.com/mikestefanello/pagoda/pkg/ui/models"
+	"github.com/mikestefanello/pagoda/pkg/ui/pages"
+)
+
+type Invitations struct {
+	config *config.Config
+	orm    *ent.Client
+	auth   *services.AuthClient
+	mail   *services.MailClient
+}
+
+func init() {
+	Register(new(Invitations))
+}
+
+func (h *Invitations) Init(c *services.Container) error {
+	h.config = c.Config
+	h.orm = c.ORM
+	h.auth = c.Auth
+	h.mail = c.Mail
+
+	// Create the permission so it can be granted to roles.
+	return h.auth.RegisterPermissions(goctx.Background(), permissions.InviteUsers)
+}
+
+func (h *Invitations) Routes(g *echo.Group) {
+	i := g.Group("/user/invitations",
+		middleware.RequireAuthentication,
+		middleware.RequireNoAPIToken,
+		middleware.RequireNoImpersonation,
+		middleware.RequirePermission(permissions.InviteUsers),
+	)
+	i.GET("", h.Page).Name = routenames.Invitations
+	i.POST("", h.Submit).Name = routenames.InvitationCreate
+	i.POST("/:invitation/revoke", h.Revoke).Name = routenames.InvitationRevoke
+}
+
+func (h *Invitations) Page(ctx echo.Context) error {
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	invitations, err := h.auth.GetInvitations(ctx, u.ID)
+	if err != nil {
+		return fail(err, "unable to load invitations")
+	}
+
+	list := make(models.Invitations, len(invitations))
+	for i, inv := range invitations {
+		list[i] = &models.Invitation{
+			ID:         inv.ID,
+			Email:      inv.Email,
+			CreatedAt:  inv.CreatedAt,
+			ExpiresAt:  inv.ExpiresAt,
+			AcceptedAt: inv.AcceptedAt,
+		}
+	}
+
+	return pages.Invitations(ctx, list, form.Get[forms.Invitation](ctx))
+}
+
+func (h *Invitations) Submit(ctx echo.Context) error {
+	var input forms.Invitation
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.Page(ctx)
+	default:
+		return err
+	}
+
+	exists, err := h.orm.User.
+		Query().
+		Where(user.Email(strings.ToLower(input.Email))).
+		Exist(ctx.Request().Context())
+	switch {
+	case err != nil:
+		return fail(err, "unable to query for user")
+	case exists:
+		msg.Warning(ctx, "A user with this email address already exists.")
+		return h.Page(ctx)
+	}
+
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	token, inv, err := h.auth.CreateInvitation(ctx, u.ID, input.Email)
+	if err != nil {
+		return fail(err, "unable to create invitation")
+	}
+
+	log.Ctx(ctx).Info("invitation created",
+		"user_id", u.ID,
+		"invitation_id", inv.ID,
+	)
+
+	link := fmt.Sprintf("%s%s?invite=%s",
+		h.config.App.Host,
+		ctx.Echo().Reverse(routenames.Register),
+		url.QueryEscape(token),
+	)
+	err = h.mail.
+		Compose().
+		To(inv.Email).
+		Subject(fmt.Sprintf("You have been invited to %s", h.config.App.Name)).
+		Body(fmt.Sprintf("%s has invited you to create an account. Go here to accept the invitation: %s", u.Name, link)).
+		Send(ctx)
+	if err != nil {
+		return fail(err, "error sending invitation email")
+	}
+
+	msg.Success(ctx, fmt.Sprintf("An invitation has been sent to %s.", inv.Email))
+	return redirect.New(ctx).
+		Route(routenames.Invitations).
+		Go()
+}
+
+func (h *Invitations) Revoke(ctx echo.Context) error {
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	id, err := strconv.Atoi(ctx.Param("invitation"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid invitation ID")
+	}
+
+	err = h.auth.RevokeInvitation(ctx, u.ID, id)
+	switch {
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound, "invitation not found")
+	case err != nil:
+		return fail(err, "unable to revoke invitation")
+	}
+
+	log.Ctx(ctx).Info("invitation revoked",
+		"user_id", u.ID,
+		"invitation_id", id,
+	)
+
+	msg.Success(ctx, "The invitation has been revoked.")
+	return redirect.New(ctx).
+		Route(routenames.Invitations).
+		Go()
+}
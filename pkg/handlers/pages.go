@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/pkg/middleware"
 	"github.com/mikestefanello/pagoda/pkg/pager"
 	"github.com/mikestefanello/pagoda/pkg/routenames"
 	"github.com/mikestefanello/pagoda/pkg/services"
@@ -11,19 +13,24 @@ import (
 	"github.com/mikestefanello/pagoda/pkg/ui/pages"
 )
 
-type Pages struct{}
+type Pages struct {
+	cache  *services.CacheClient
+	config *config.Config
+}
 
 func init() {
 	Register(new(Pages))
 }
 
 func (h *Pages) Init(c *services.Container) error {
+	h.cache = c.Cache
+	h.config = c.Config
 	return nil
 }
 
 func (h *Pages) Routes(g *echo.Group) {
 	g.GET("/", h.Home).Name = routenames.Home
-	g.GET("/about", h.About).Name = routenames.About
+	g.GET("/about", h.About, middleware.CachePage(h.cache, h.config.Cache.Expiration.Page)).Name = routenames.About
 }
 
 func (h *Pages) Home(ctx echo.Context) error {
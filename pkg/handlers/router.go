@@ -4,7 +4,6 @@ import (
 	"net/http"
 	"strings"
 
-	"github.com/gorilla/sessions"
 	"github.com/labstack/echo/v4"
 	echomw "github.com/labstack/echo/v4/middleware"
 	"github.com/mikestefanello/pagoda/pkg/context"
@@ -48,11 +47,6 @@ func BuildRouter(c *services.Container) error {
 	// Non-static file route group.
 	g := c.Web.Group("")
 
-	// Create a cookie store for session data.
-	cookieStore := sessions.NewCookieStore([]byte(c.Config.App.EncryptionKey))
-	cookieStore.Options.HttpOnly = true
-	cookieStore.Options.SameSite = http.SameSiteStrictMode
-
 	g.Use(
 		echomw.RemoveTrailingSlashWithConfig(echomw.TrailingSlashConfig{
 			RedirectCode: http.StatusMovedPermanently,
@@ -67,9 +61,15 @@ func BuildRouter(c *services.Container) error {
 			Timeout: c.Config.App.Timeout,
 		}),
 		middleware.Config(c.Config),
-		middleware.Session(cookieStore),
+		middleware.Session(c.Session),
 		middleware.LoadAuthenticatedUser(c.Auth),
+		middleware.LoadAPITokenUser(c.Auth),
+		middleware.ServeCachedPage(c.Cache),
 		echomw.CSRFWithConfig(echomw.CSRFConfig{
+			// Requests authenticated with an API token do not rely on cookies, so they cannot be forged.
+			Skipper: func(c echo.Context) bool {
+				return c.Get(context.APITokenKey) != nil
+			},
 			TokenLookup:    "form:csrf",
 			CookieHTTPOnly: true,
 			CookieSameSite: http.SameSiteStrictMode,
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
 	"github.com/mikestefanello/pagoda/pkg/services"
 
 	"github.com/PuerkitoBio/goquery"
@@ -29,6 +30,22 @@ func TestMain(m *testing.M) {
 	// Start a new container
 	c = services.NewContainer()
 
+	// Add an OAuth provider which approves every login
+	oauthProvider := newFakeOAuthProvider()
+	c.Config.OAuth.Providers = map[string]config.OAuthProviderConfig{
+		"fake": {
+			ClientID:     "client",
+			ClientSecret: "secret",
+			AuthURL:      oauthProvider.URL + "/authorize",
+			TokenURL:     oauthProvider.URL + "/token",
+			UserInfoURL:  oauthProvider.URL + "/userinfo",
+		},
+	}
+	var err error
+	if c.Auth, err = services.NewAuthClient(c.Config, c.ORM); err != nil {
+		panic(err)
+	}
+
 	// Start a test HTTP server
 	if err := BuildRouter(c); err != nil {
 		panic(err)
@@ -43,6 +60,7 @@ func TestMain(m *testing.M) {
 		panic(err)
 	}
 	srv.Close()
+	oauthProvider.Close()
 
 	os.Exit(exitVal)
 }
@@ -136,3 +154,63 @@ func (h *httpResponse) toDoc() *goquery.Document {
 	assert.NoError(h.t, err)
 	return doc
 }
+
+// session is an HTTP client which is logged in as a given user and does not follow redirects, for testing routes
+// which require authentication.
+type session struct {
+	client http.Client
+	csrf   string
+	t      *testing.T
+}
+
+func login(t *testing.T, email string) *session {
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	s := session{
+		t: t,
+		client: http.Client{
+			Jar: jar,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+
+	// Load the home page first so the CSRF cookie applies to every path.
+	resp := s.get(routeURL(routenames.Home))
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == "_csrf" {
+			s.csrf = cookie.Value
+		}
+	}
+	require.NotEmpty(t, s.csrf)
+
+	resp = s.post(routeURL(routenames.LoginSubmit), url.Values{
+		"email":    []string{email},
+		"password": []string{"password"},
+	})
+	require.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+	return &s
+}
+
+func (s *session) get(u string) *http.Response {
+	resp, err := s.client.Get(u)
+	require.NoError(s.t, err)
+	require.NoError(s.t, resp.Body.Close())
+	return resp
+}
+
+func (s *session) post(u string, body url.Values) *http.Response {
+	if body == nil {
+		body = url.Values{}
+	}
+	body.Set("csrf", s.csrf)
+	resp, err := s.client.PostForm(u, body)
+	require.NoError(s.t, err)
+	require.NoError(s.t, resp.Body.Close())
+	return resp
+}
+
+func routeURL(route string, params ...any) string {
+	return srv.URL + c.Web.Reverse(route, params...)
+}
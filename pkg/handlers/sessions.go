@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/log"
+	"github.com/mikestefanello/pagoda/pkg/middleware"
+	"github.com/mikestefanello/pagoda/pkg/msg"
+	"github.com/mikestefanello/pagoda/pkg/redirect"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/ui/models"
+	"github.com/mikestefanello/pagoda/pkg/ui/pages"
+)
+
+type Sessions struct {
+	auth *services.AuthClient
+}
+
+func init() {
+	Register(new(Sessions))
+}
+
+func (h *Sessions) Init(c *services.Container) error {
+	h.auth = c.Auth
+	return nil
+}
+
+func (h *Sessions) Routes(g *echo.Group) {
+	s := g.Group("/user/sessions",
+		middleware.RequireAuthentication,
+		middleware.RequireNoAPIToken,
+		middleware.RequireNoImpersonation,
+	)
+	s.GET("", h.Page).Name = routenames.Sessions
+	s.POST("/:session/revoke", h.Revoke).Name = routenames.SessionRevoke
+}
+
+func (h *Sessions) Page(ctx echo.Context) error {
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	sessions, err := h.auth.GetSessions(ctx, u.ID)
+	if err != nil {
+		return fail(err, "unable to load sessions")
+	}
+
+	list := make(models.Sessions, len(sessions))
+	for i, s := range sessions {
+		list[i] = &models.Session{
+			ID:         s.ID,
+			IP:         s.IP,
+			UserAgent:  s.UserAgent,
+			CreatedAt:  s.CreatedAt,
+			LastSeenAt: s.LastSeenAt,
+			Current:    h.auth.IsCurrentSession(ctx, s),
+		}
+	}
+
+	return pages.Sessions(ctx, list)
+}
+
+func (h *Sessions) Revoke(ctx echo.Context) error {
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	id, err := strconv.Atoi(ctx.Param("session"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid session ID")
+	}
+
+	err = h.auth.RevokeSession(ctx, u.ID, id)
+	switch {
+	case ent.IsNotFound(err):
+		return echo.NewHTTPError(http.StatusNotFound, "session not found")
+	case err != nil:
+		return fail(err, "unable to revoke session")
+	}
+
+	log.Ctx(ctx).Info("session revoked",
+		"user_id", u.ID,
+		"session_id", id,
+	)
+
+	msg.Success(ctx, "The session has been revoked.")
+	return redirect.New(ctx).
+		Route(routenames.Sessions).
+		Go()
+}
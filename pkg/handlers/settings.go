@@ -0,0 +1,328 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/form"
+	"github.com/mikestefanello/pagoda/pkg/log"
+	"github.com/mikestefanello/pagoda/pkg/middleware"
+	"github.com/mikestefanello/pagoda/pkg/msg"
+	"github.com/mikestefanello/pagoda/pkg/redirect"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/ui/forms"
+	"github.com/mikestefanello/pagoda/pkg/ui/pages"
+)
+
+type Settings struct {
+	config  *config.Config
+	auth    *services.AuthClient
+	mail    *services.MailClient
+	orm     *ent.Client
+	limiter *services.RateLimiter
+}
+
+func init() {
+	Register(new(Settings))
+}
+
+func (h *Settings) Init(c *services.Container) error {
+	h.config = c.Config
+	h.auth = c.Auth
+	h.mail = c.Mail
+	h.orm = c.ORM
+	h.limiter = c.RateLimiter
+	return nil
+}
+
+func (h *Settings) Routes(g *echo.Group) {
+	g.GET("/email/change/:token", h.ChangeEmail).Name = routenames.ChangeEmail
+
+	s := g.Group("/user/settings",
+		middleware.RequireAuthentication,
+		middleware.RequireNoAPIToken,
+		middleware.RequireNoImpersonation,
+	)
+	s.GET("", h.Page).Name = routenames.Settings
+	s.POST("/name", h.NameSubmit).Name = routenames.SettingsName
+	s.POST("/email", h.EmailSubmit,
+		middleware.RateLimit(h.limiter, "email_change_user", h.config.RateLimit.EmailChange, middleware.RateLimitByUser),
+		middleware.RateLimit(h.limiter, "email_change_email", h.config.RateLimit.EmailChange, middleware.RateLimitByFormField("email")),
+	).Name = routenames.SettingsEmail
+	s.POST("/password", h.PasswordSubmit,
+		middleware.RateLimit(h.limiter, "change_password", h.config.RateLimit.Login, middleware.RateLimitByUser),
+	).Name = routenames.SettingsPassword
+	s.POST("/delete", h.DeleteSubmit,
+		middleware.RateLimit(h.limiter, "delete_account", h.config.RateLimit.Login, middleware.RateLimitByUser),
+	).Name = routenames.SettingsDelete
+}
+
+func (h *Settings) Page(ctx echo.Context) error {
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	// Show the current name unless the form is being shown again.
+	name := form.Get[forms.ChangeName](ctx)
+	if !name.IsSubmitted() {
+		name.Name = u.Name
+	}
+
+	return pages.Settings(
+		ctx,
+		name,
+		form.Get[forms.ChangeEmail](ctx),
+		form.Get[forms.ChangePassword](ctx),
+		form.Get[forms.DeleteAccount](ctx),
+	)
+}
+
+func (h *Settings) NameSubmit(ctx echo.Context) error {
+	var input forms.ChangeName
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.Page(ctx)
+	default:
+		return err
+	}
+
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	_, err = u.
+		Update().
+		SetName(input.Name).
+		Save(ctx.Request().Context())
+
+	if err != nil {
+		return fail(err, "unable to update name")
+	}
+
+	msg.Success(ctx, "Your name has been updated.")
+	return redirect.New(ctx).
+		Route(routenames.Settings).
+		Go()
+}
+
+func (h *Settings) EmailSubmit(ctx echo.Context) error {
+	var input forms.ChangeEmail
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.Page(ctx)
+	default:
+		return err
+	}
+
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := h.auth.CheckPassword(input.Password, u.Password); err != nil {
+		input.SetFieldError("Password", "The password is incorrect.")
+		return h.Page(ctx)
+	}
+
+	email := strings.ToLower(input.Email)
+	if email == u.Email {
+		input.SetFieldError("Email", "This is already your email address.")
+		return h.Page(ctx)
+	}
+
+	succeed := func() error {
+		form.Clear(ctx)
+		msg.Success(ctx, fmt.Sprintf("A link to confirm the change has been sent to %s.", email))
+		return redirect.New(ctx).
+			Route(routenames.Settings).
+			Go()
+	}
+
+	// Do not reveal whether the address belongs to another user, the change simply cannot be confirmed.
+	exists, err := h.orm.User.
+		Query().
+		Where(user.Email(email)).
+		Exist(ctx.Request().Context())
+	if err != nil {
+		return fail(err, "error querying user during email change")
+	}
+	if exists {
+		return succeed()
+	}
+
+	token, err := h.auth.GenerateEmailChangeToken(services.EmailChange{
+		UserID:   u.ID,
+		OldEmail: u.Email,
+		NewEmail: email,
+	})
+	if err != nil {
+		return fail(err, "error generating email change token")
+	}
+
+	log.Ctx(ctx).Info("generated email change token",
+		"user_id", u.ID,
+	)
+
+	// Email the new address, which is verified by confirming the change.
+	url := ctx.Echo().Reverse(routenames.ChangeEmail, token)
+	err = h.mail.
+		Compose().
+		To(email).
+		Subject("Confirm your new email address").
+		Body(fmt.Sprintf("Go here to confirm the change of your email address: %s", h.config.App.Host+url)).
+		Send(ctx)
+
+	if err != nil {
+		return fail(err, "error sending email change email")
+	}
+
+	return succeed()
+}
+
+func (h *Settings) PasswordSubmit(ctx echo.Context) error {
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	input := forms.ChangePassword{
+		Email: u.Email,
+		Name:  u.Name,
+	}
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.Page(ctx)
+	default:
+		return err
+	}
+
+	if err := h.auth.CheckPassword(input.CurrentPassword, u.Password); err != nil {
+		input.SetFieldError("CurrentPassword", "The password is incorrect.")
+		return h.Page(ctx)
+	}
+
+	_, err = u.
+		Update().
+		SetPassword(input.Password).
+		Save(ctx.Request().Context())
+
+	if err != nil {
+		return fail(err, "unable to update password")
+	}
+
+	// Password reset links sent before the change should no longer work.
+	err = h.auth.DeletePasswordTokens(ctx, u.ID)
+	if err != nil {
+		return fail(err, "unable to delete password tokens")
+	}
+
+	// Log out all other sessions of this user, in case the password was changed because the account was compromised.
+	err = h.auth.RevokeOtherSessions(ctx, u.ID)
+	if err != nil {
+		return fail(err, "unable to revoke sessions")
+	}
+
+	log.Ctx(ctx).Info("password changed",
+		"user_id", u.ID,
+	)
+
+	form.Clear(ctx)
+	msg.Success(ctx, "Your password has been changed.")
+	return redirect.New(ctx).
+		Route(routenames.Settings).
+		Go()
+}
+
+func (h *Settings) DeleteSubmit(ctx echo.Context) error {
+	var input forms.DeleteAccount
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.Page(ctx)
+	default:
+		return err
+	}
+
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := h.auth.CheckPassword(input.Password, u.Password); err != nil {
+		input.SetFieldError("Password", "The password is incorrect.")
+		return h.Page(ctx)
+	}
+
+	if err := h.auth.DeleteUser(ctx, u.ID); err != nil {
+		return fail(err, "unable to delete user")
+	}
+
+	log.Ctx(ctx).Info("user deleted",
+		"user_id", u.ID,
+	)
+
+	if err := h.auth.Logout(ctx); err != nil {
+		log.Ctx(ctx).Error("error logging out deleted user",
+			"user_id", u.ID,
+			"error", err,
+		)
+	}
+
+	msg.Success(ctx, "Your account has been deleted.")
+	return redirect.New(ctx).
+		Route(routenames.Home).
+		Go()
+}
+
+func (h *Settings) ChangeEmail(ctx echo.Context) error {
+	change, err := h.auth.ChangeEmail(ctx, ctx.Param("token"))
+
+	switch err.(type) {
+	case nil:
+	case services.InvalidEmailChangeError:
+		msg.Warning(ctx, "The link is either invalid or has expired.")
+		return redirect.New(ctx).
+			Route(routenames.Home).
+			Go()
+	case services.EmailTakenError:
+		msg.Warning(ctx, "This email address is already in use by another account.")
+		return redirect.New(ctx).
+			Route(routenames.Home).
+			Go()
+	default:
+		return fail(err, "unable to change email")
+	}
+
+	log.Ctx(ctx).Info("email changed",
+		"user_id", change.UserID,
+	)
+
+	// Notify the old address, in case the change was not made by the owner of the account.
+	err = h.mail.
+		Compose().
+		To(change.OldEmail).
+		Subject("Your email address has been changed").
+		Body(fmt.Sprintf("The email address of your account has been changed to %s. If you did not make this change, contact us immediately.", change.NewEmail)).
+		Send(ctx)
+
+	if err != nil {
+		log.Ctx(ctx).Error("unable to send email change notification",
+			"user_id", change.UserID,
+			"error", err,
+		)
+	}
+
+	msg.Success(ctx, fmt.Sprintf("Your email address has been changed to %s.", change.NewEmail))
+	return redirect.New(ctx).
+		Route(routenames.Home).
+		Go()
+}
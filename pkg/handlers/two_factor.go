@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image/png"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/form"
+	"github.com/mikestefanello/pagoda/pkg/log"
+	"github.com/mikestefanello/pagoda/pkg/middleware"
+	"github.com/mikestefanello/pagoda/pkg/msg"
+	"github.com/mikestefanello/pagoda/pkg/redirect"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/ui/forms"
+	"github.com/mikestefanello/pagoda/pkg/ui/models"
+	"github.com/mikestefanello/pagoda/pkg/ui/pages"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+type TwoFactor struct {
+	auth    *services.AuthClient
+	config  *config.Config
+	limiter *services.RateLimiter
+}
+
+func init() {
+	Register(new(TwoFactor))
+}
+
+func (h *TwoFactor) Init(c *services.Container) error {
+	h.auth = c.Auth
+	h.config = c.Config
+	h.limiter = c.RateLimiter
+	return nil
+}
+
+func (h *TwoFactor) Routes(g *echo.Group) {
+	tf := g.Group("/user/2fa",
+		middleware.RequireAuthentication,
+		middleware.RequireNoAPIToken,
+		middleware.RequireNoImpersonation,
+	)
+	tf.GET("", h.Page).Name = routenames.TwoFactor
+	tf.POST("/enable", h.EnableSubmit).Name = routenames.TwoFactorEnable
+	tf.POST("/disable", h.DisableSubmit,
+		middleware.RateLimit(h.limiter, "two_factor_disable", h.config.RateLimit.Login, middleware.RateLimitByUser),
+	).Name = routenames.TwoFactorDisable
+}
+
+func (h *TwoFactor) Page(ctx echo.Context) error {
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	if u.TotpSecret != "" {
+		return pages.TwoFactor(ctx, nil, nil, form.Get[forms.TwoFactorDisable](ctx))
+	}
+
+	// Keep the key being enrolled if the form is being shown again, otherwise generate a new one.
+	enable := form.Get[forms.TwoFactorEnable](ctx)
+	var key *otp.Key
+	var err error
+	if enable.Key != "" {
+		key, err = h.decryptKey(enable.Key)
+	} else {
+		key, err = h.auth.GenerateTOTPKey(u)
+	}
+	if err != nil {
+		return fail(err, "unable to get TOTP key")
+	}
+
+	// The key is encrypted and included in the form so it does not have to be stored until it is confirmed.
+	if enable.Key, err = h.auth.Encrypt(key.URL()); err != nil {
+		return fail(err, "unable to encrypt TOTP key")
+	}
+
+	setup, err := h.setup(key)
+	if err != nil {
+		return fail(err, "unable to render TOTP key")
+	}
+
+	return pages.TwoFactor(ctx, setup, enable, nil)
+}
+
+func (h *TwoFactor) EnableSubmit(ctx echo.Context) error {
+	var input forms.TwoFactorEnable
+
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	if u.TotpSecret != "" {
+		return redirect.New(ctx).
+			Route(routenames.TwoFactor).
+			Go()
+	}
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.Page(ctx)
+	default:
+		return err
+	}
+
+	key, err := h.decryptKey(input.Key)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid TOTP key")
+	}
+
+	if !totp.Validate(input.Code, key.Secret()) {
+		input.SetFieldError("Code", "The code is invalid. Please try again.")
+		return h.Page(ctx)
+	}
+
+	codes, err := h.auth.EnableTOTP(ctx, u.ID, key.Secret())
+	if err != nil {
+		return fail(err, "unable to enable TOTP")
+	}
+
+	log.Ctx(ctx).Info("two-factor authentication enabled",
+		"user_id", u.ID,
+	)
+
+	return pages.RecoveryCodes(ctx, codes)
+}
+
+func (h *TwoFactor) DisableSubmit(ctx echo.Context) error {
+	var input forms.TwoFactorDisable
+
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	if u.TotpSecret == "" {
+		return redirect.New(ctx).
+			Route(routenames.TwoFactor).
+			Go()
+	}
+
+	err := form.Submit(ctx, &input)
+
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.Page(ctx)
+	default:
+		return err
+	}
+
+	valid, err := h.auth.ValidateTOTP(ctx, u, input.Code)
+	if err != nil {
+		return fail(err, "unable to validate TOTP code")
+	}
+
+	if !valid {
+		if valid, err = h.auth.UseRecoveryCode(ctx, u.ID, input.Code); err != nil {
+			return fail(err, "unable to check recovery codes")
+		}
+	}
+
+	if !valid {
+		input.SetFieldError("Code", "The code is invalid. Please try again.")
+		return h.Page(ctx)
+	}
+
+	if err = h.auth.DisableTOTP(ctx, u.ID); err != nil {
+		return fail(err, "unable to disable TOTP")
+	}
+
+	log.Ctx(ctx).Info("two-factor authentication disabled",
+		"user_id", u.ID,
+	)
+
+	msg.Success(ctx, "Two-factor authentication has been disabled.")
+	return redirect.New(ctx).
+		Route(routenames.TwoFactor).
+		Go()
+}
+
+// decryptKey decrypts a TOTP key which was encrypted in order to be included in the enrollment form.
+func (h *TwoFactor) decryptKey(encrypted string) (*otp.Key, error) {
+	url, err := h.auth.Decrypt(encrypted)
+	if err != nil {
+		return nil, err
+	}
+	return otp.NewKeyFromURL(url)
+}
+
+// setup provides the data needed to add a given TOTP key to an authenticator app.
+func (h *TwoFactor) setup(key *otp.Key) (*models.TwoFactorSetup, error) {
+	img, err := key.Image(200, 200)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err = png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+
+	return &models.TwoFactorSetup{
+		QRCode: "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()),
+		Secret: key.Secret(),
+	}, nil
+}
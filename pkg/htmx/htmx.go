@@ -24,6 +24,8 @@ const (
 	HeaderRedirect           = "HX-Redirect"
 	HeaderReplaceURL         = "HX-Replace-Url"
 	HeaderRefresh            = "HX-Refresh"
+	HeaderReswap             = "HX-Reswap"
+	HeaderRetarget           = "HX-Retarget"
 	HeaderTriggerAfterSettle = "HX-Trigger-After-Settle"
 	HeaderTriggerAfterSwap   = "HX-Trigger-After-Swap"
 )
@@ -46,6 +48,8 @@ type (
 		Redirect           string
 		Refresh            bool
 		ReplaceURL         string
+		Retarget           string
+		Reswap             string
 		Trigger            string
 		TriggerAfterSwap   string
 		TriggerAfterSettle string
@@ -91,6 +95,12 @@ func (r Response) Apply(ctx echo.Context) {
 	if r.ReplaceURL != "" {
 		ctx.Response().Header().Set(HeaderReplaceURL, r.ReplaceURL)
 	}
+	if r.Retarget != "" {
+		ctx.Response().Header().Set(HeaderRetarget, r.Retarget)
+	}
+	if r.Reswap != "" {
+		ctx.Response().Header().Set(HeaderReswap, r.Reswap)
+	}
 	if r.NoContent {
 		ctx.Response().Status = http.StatusNoContent
 	}
@@ -43,6 +43,8 @@ func TestResponse_Apply(t *testing.T) {
 		PushURL:            "a",
 		Redirect:           "b",
 		ReplaceURL:         "f",
+		Retarget:           "g",
+		Reswap:             "h",
 		Refresh:            true,
 		Trigger:            "c",
 		TriggerAfterSwap:   "d",
@@ -58,5 +60,7 @@ func TestResponse_Apply(t *testing.T) {
 	assert.Equal(t, "d", ctx.Response().Header().Get(HeaderTriggerAfterSwap))
 	assert.Equal(t, "e", ctx.Response().Header().Get(HeaderTriggerAfterSettle))
 	assert.Equal(t, "f", ctx.Response().Header().Get(HeaderReplaceURL))
+	assert.Equal(t, "g", ctx.Response().Header().Get(HeaderRetarget))
+	assert.Equal(t, "h", ctx.Response().Header().Get(HeaderReswap))
 	assert.Equal(t, http.StatusNoContent, ctx.Response().Status)
 }
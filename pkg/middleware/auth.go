@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/mikestefanello/pagoda/ent"
 	"github.com/mikestefanello/pagoda/pkg/context"
@@ -15,17 +17,66 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-// LoadAuthenticatedUser loads the authenticated user, if one, and stores in context.
+// LoadAuthenticatedUser loads the authenticated user, if one, and stores it in context along with their permissions
+// and the admin impersonating them, if any. Users who asked to be remembered are logged back in once their session has
+// expired.
 func LoadAuthenticatedUser(authClient *services.AuthClient) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			u, err := authClient.GetAuthenticatedUser(c)
-			switch err.(type) {
+
+			// Log the user back in if they asked to be remembered.
+			if _, ok := err.(services.NotAuthenticatedError); ok {
+				u, err = authClient.LoginRemembered(c)
+			}
+
+			switch e := err.(type) {
 			case *ent.NotFoundError:
 				log.Ctx(c).Warn("auth user not found")
 			case services.NotAuthenticatedError:
+			case services.RememberTokenReusedError:
+				log.Ctx(c).Warn("remember me token reused, all sessions of the user have been revoked",
+					"user_id", e.UserID,
+				)
+			case services.AccountLockedError, services.LoginThrottledError, services.UnverifiedError:
+				log.Ctx(c).Info("remembered user not allowed to log in",
+					"error", e,
+				)
 			case nil:
+				permissions, err := authClient.GetPermissions(c, u.ID)
+				if err != nil {
+					return echo.NewHTTPError(
+						http.StatusInternalServerError,
+						fmt.Sprintf("error querying for authenticated user permissions: %v", err),
+					)
+				}
+
+				// Sessions of admins who are no longer allowed to impersonate the user are logged out.
+				impersonator, err := authClient.GetImpersonator(c)
+				switch err.(type) {
+				case nil:
+					c.Set(context.ImpersonatorKey, impersonator)
+				case services.NotImpersonatingError:
+				case services.ImpersonationNotAllowedError:
+					log.Ctx(c).Warn("impersonation no longer allowed, logging out",
+						"user_id", u.ID,
+					)
+					if err = authClient.Logout(c); err != nil {
+						return echo.NewHTTPError(
+							http.StatusInternalServerError,
+							fmt.Sprintf("error logging out: %v", err),
+						)
+					}
+					return next(c)
+				default:
+					return echo.NewHTTPError(
+						http.StatusInternalServerError,
+						fmt.Sprintf("error querying for impersonator: %v", err),
+					)
+				}
+
 				c.Set(context.AuthenticatedUserKey, u)
+				c.Set(context.PermissionsKey, permissions)
 			default:
 				return echo.NewHTTPError(
 					http.StatusInternalServerError,
@@ -38,6 +89,89 @@ func LoadAuthenticatedUser(authClient *services.AuthClient) echo.MiddlewareFunc
 	}
 }
 
+// LoadAPITokenUser authenticates requests which provide an API token in the Authorization header, as a bearer token,
+// and stores the user that owns it in context along with their permissions and the token. The token must have the
+// read scope for requests which are safe, such as GET requests, and the write scope for all others, and the user
+// cannot be locked. Requests without an API token are left to be authenticated by their session.
+func LoadAPITokenUser(authClient *services.AuthClient) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Request().Header.Get(echo.HeaderAuthorization)
+			if header == "" {
+				return next(c)
+			}
+
+			scheme, token, ok := strings.Cut(header, " ")
+			if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+				return echo.NewHTTPError(http.StatusUnauthorized)
+			}
+
+			t, err := authClient.GetAPIToken(c, token)
+			switch err.(type) {
+			case nil:
+			case services.InvalidAPITokenError:
+				return echo.NewHTTPError(http.StatusUnauthorized)
+			default:
+				return echo.NewHTTPError(
+					http.StatusInternalServerError,
+					fmt.Sprintf("error querying for api token: %v", err),
+				)
+			}
+
+			// Tokens cannot be used while the account they belong to is locked.
+			if t.Edges.User.LockedUntil.After(time.Now()) {
+				return echo.NewHTTPError(http.StatusUnauthorized)
+			}
+
+			scope := services.APITokenScopeWrite
+			switch c.Request().Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				scope = services.APITokenScopeRead
+			}
+			if !services.HasAPITokenScope(t, scope) {
+				return echo.NewHTTPError(http.StatusForbidden)
+			}
+
+			permissions, err := authClient.GetPermissions(c, t.UserID)
+			if err != nil {
+				return echo.NewHTTPError(
+					http.StatusInternalServerError,
+					fmt.Sprintf("error querying for api token user permissions: %v", err),
+				)
+			}
+
+			c.Set(context.AuthenticatedUserKey, t.Edges.User)
+			c.Set(context.PermissionsKey, permissions)
+			c.Set(context.APITokenKey, t)
+
+			return next(c)
+		}
+	}
+}
+
+// LoadPendingUser loads the user that the session is pending the second factor for, and stores it in context.
+// If the session is not pending the second factor, or it has expired, the user is redirected to the login route.
+func LoadPendingUser(authClient *services.AuthClient) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			u, err := authClient.GetPendingUser(c)
+			switch err.(type) {
+			case nil:
+				c.Set(context.UserKey, u)
+				return next(c)
+			case *ent.NotFoundError, services.NotAuthenticatedError:
+				msg.Warning(c, "Your login has expired. Please log in again.")
+				return c.Redirect(http.StatusFound, c.Echo().Reverse(routenames.Login))
+			default:
+				return echo.NewHTTPError(
+					http.StatusInternalServerError,
+					fmt.Sprintf("error querying for pending user: %v", err),
+				)
+			}
+		}
+	}
+}
+
 // LoadValidPasswordToken loads a valid password token entity that matches the user and token
 // provided in path parameters
 // If the token is invalid, the user will be redirected to the forgot password route
@@ -104,6 +238,47 @@ func RequireNoAuthentication(next echo.HandlerFunc) echo.HandlerFunc {
 	}
 }
 
+// RequireNoAPIToken requires that the request not be authenticated with an API token in order to proceed, which
+// prevents tokens from being used to manage the account they belong to.
+func RequireNoAPIToken(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if t := c.Get(context.APITokenKey); t != nil {
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		return next(c)
+	}
+}
+
+// RequireNoImpersonation requires that the session not be an admin impersonating a user in order to proceed, which
+// prevents admins from managing the credentials and account of the user they are impersonating.
+func RequireNoImpersonation(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if i := c.Get(context.ImpersonatorKey); i != nil {
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+
+		return next(c)
+	}
+}
+
+// RequireVerified requires that the authenticated user have verified their email address in order to proceed.
+// Unverified users are redirected to a page which asks them to verify it.
+func RequireVerified(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		u, ok := c.Get(context.AuthenticatedUserKey).(*ent.User)
+		if !ok {
+			return echo.NewHTTPError(http.StatusUnauthorized)
+		}
+
+		if !u.Verified {
+			return c.Redirect(http.StatusFound, c.Echo().Reverse(routenames.VerifyEmailNotice))
+		}
+
+		return next(c)
+	}
+}
+
 // RequireAdmin requires that the authenticated user be an admin in order to proceed.
 func RequireAdmin(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -118,3 +293,22 @@ func RequireAdmin(next echo.HandlerFunc) echo.HandlerFunc {
 		return echo.NewHTTPError(http.StatusUnauthorized)
 	}
 }
+
+// RequirePermission requires that the authenticated user have a given permission in order to proceed.
+// Admins have every permission.
+func RequirePermission(permission string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			u, ok := c.Get(context.AuthenticatedUserKey).(*ent.User)
+			if !ok {
+				return echo.NewHTTPError(http.StatusUnauthorized)
+			}
+
+			if permissions, _ := c.Get(context.PermissionsKey).(map[string]bool); u.Admin || permissions[permission] {
+				return next(c)
+			}
+
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+	}
+}
@@ -5,9 +5,14 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/labstack/echo/v4"
 	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/permission"
 	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/services"
 	"github.com/mikestefanello/pagoda/pkg/tests"
 
 	"github.com/stretchr/testify/require"
@@ -36,6 +41,90 @@ func TestLoadAuthenticatedUser(t *testing.T) {
 	assert.Equal(t, usr.ID, ctxUsr.ID)
 }
 
+func TestLoadAPITokenUser(t *testing.T) {
+	mw := LoadAPITokenUser(c.Auth)
+
+	// request executes the middleware for a request with a given method and authorization header.
+	request := func(method, authorization string) (echo.Context, error) {
+		ctx, _ := tests.NewContext(c.Web, "/")
+		ctx.Request().Method = method
+		if authorization != "" {
+			ctx.Request().Header.Set(echo.HeaderAuthorization, authorization)
+		}
+		return ctx, tests.ExecuteMiddleware(ctx, mw)
+	}
+
+	// No token
+	ctx, err := request(http.MethodGet, "")
+	require.NoError(t, err)
+	assert.Nil(t, ctx.Get(context.AuthenticatedUserKey))
+
+	// Invalid tokens
+	_, err = request(http.MethodGet, "Bearer invalid")
+	tests.AssertHTTPErrorCode(t, err, http.StatusUnauthorized)
+	_, err = request(http.MethodGet, "Basic dXNlcjpwYXNz")
+	tests.AssertHTTPErrorCode(t, err, http.StatusUnauthorized)
+
+	// Valid token
+	token, created, err := c.Auth.CreateAPIToken(
+		ctx, usr.ID, "Read", []string{services.APITokenScopeRead}, time.Time{},
+	)
+	require.NoError(t, err)
+	ctx, err = request(http.MethodGet, "bearer "+token)
+	require.NoError(t, err)
+	ctxUsr, ok := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	require.True(t, ok)
+	assert.Equal(t, usr.ID, ctxUsr.ID)
+	ctxToken, ok := ctx.Get(context.APITokenKey).(*ent.APIToken)
+	require.True(t, ok)
+	assert.Equal(t, created.ID, ctxToken.ID)
+	assert.NotNil(t, ctx.Get(context.PermissionsKey))
+
+	// Which cannot make changes without the write scope
+	_, err = request(http.MethodPost, "Bearer "+token)
+	tests.AssertHTTPErrorCode(t, err, http.StatusForbidden)
+
+	// Expired token
+	token, _, err = c.Auth.CreateAPIToken(
+		ctx, usr.ID, "Expired", services.APITokenScopes, time.Now().Add(-time.Minute),
+	)
+	require.NoError(t, err)
+	_, err = request(http.MethodPost, "Bearer "+token)
+	tests.AssertHTTPErrorCode(t, err, http.StatusUnauthorized)
+
+	// Token of a locked user
+	locked, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	token, _, err = c.Auth.CreateAPIToken(ctx, locked.ID, "Locked", services.APITokenScopes, time.Time{})
+	require.NoError(t, err)
+	_, err = locked.Update().SetLockedUntil(time.Now().Add(time.Hour)).Save(ctx.Request().Context())
+	require.NoError(t, err)
+	_, err = request(http.MethodGet, "Bearer "+token)
+	tests.AssertHTTPErrorCode(t, err, http.StatusUnauthorized)
+}
+
+func TestRequireNoAPIToken(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+
+	err := tests.ExecuteMiddleware(ctx, RequireNoAPIToken)
+	assert.Nil(t, err)
+
+	ctx.Set(context.APITokenKey, &ent.APIToken{})
+	err = tests.ExecuteMiddleware(ctx, RequireNoAPIToken)
+	tests.AssertHTTPErrorCode(t, err, http.StatusForbidden)
+}
+
+func TestRequireNoImpersonation(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+
+	err := tests.ExecuteMiddleware(ctx, RequireNoImpersonation)
+	assert.Nil(t, err)
+
+	ctx.Set(context.ImpersonatorKey, &ent.User{})
+	err = tests.ExecuteMiddleware(ctx, RequireNoImpersonation)
+	tests.AssertHTTPErrorCode(t, err, http.StatusForbidden)
+}
+
 func TestRequireAuthentication(t *testing.T) {
 	ctx, _ := tests.NewContext(c.Web, "/")
 	tests.InitSession(ctx)
@@ -143,3 +232,83 @@ func TestRequireAdmin(t *testing.T) {
 	err = tests.ExecuteMiddleware(ctx, RequireAdmin)
 	assert.Nil(t, err)
 }
+
+func TestRequireVerified(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+
+	// Not logged in
+	err := tests.ExecuteMiddleware(ctx, RequireVerified)
+	tests.AssertHTTPErrorCode(t, err, http.StatusUnauthorized)
+
+	// Logged in as an unverified user
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	u, err = u.Update().
+		SetVerified(false).
+		Save(goctx.Background())
+	require.NoError(t, err)
+	err = c.Auth.Login(ctx, u.ID)
+	require.NoError(t, err)
+	_ = tests.ExecuteMiddleware(ctx, LoadAuthenticatedUser(c.Auth))
+
+	err = tests.ExecuteMiddleware(ctx, RequireVerified)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusFound, ctx.Response().Status)
+	assert.Equal(t, c.Web.Reverse(routenames.VerifyEmailNotice), ctx.Response().Header().Get(echo.HeaderLocation))
+
+	// Logged in as a verified user
+	ctx, _ = tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+	err = u.Update().
+		SetVerified(true).
+		Exec(goctx.Background())
+	require.NoError(t, err)
+	err = c.Auth.Login(ctx, u.ID)
+	require.NoError(t, err)
+	_ = tests.ExecuteMiddleware(ctx, LoadAuthenticatedUser(c.Auth))
+
+	err = tests.ExecuteMiddleware(ctx, RequireVerified)
+	assert.Nil(t, err)
+}
+
+func TestRequirePermission(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+	mw := RequirePermission("entity.user.edit")
+
+	// Not logged in
+	err := tests.ExecuteMiddleware(ctx, mw)
+	tests.AssertHTTPErrorCode(t, err, http.StatusUnauthorized)
+
+	// Logged in without the permission
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	err = c.Auth.Login(ctx, u.ID)
+	require.NoError(t, err)
+	_ = tests.ExecuteMiddleware(ctx, LoadAuthenticatedUser(c.Auth))
+
+	err = tests.ExecuteMiddleware(ctx, mw)
+	tests.AssertHTTPErrorCode(t, err, http.StatusForbidden)
+
+	// Grant the permission via a role
+	require.NoError(t, c.Auth.RegisterPermissions(goctx.Background(), "entity.user.edit"))
+	p, err := c.ORM.Permission.Query().
+		Where(permission.Name("entity.user.edit")).
+		Only(goctx.Background())
+	require.NoError(t, err)
+	err = c.ORM.Role.Create().
+		SetName("editor").
+		AddPermissions(p).
+		AddUsers(u).
+		Exec(goctx.Background())
+	require.NoError(t, err)
+	_ = tests.ExecuteMiddleware(ctx, LoadAuthenticatedUser(c.Auth))
+
+	err = tests.ExecuteMiddleware(ctx, mw)
+	assert.Nil(t, err)
+
+	// Other permissions are still required
+	err = tests.ExecuteMiddleware(ctx, RequirePermission("entity.user.delete"))
+	tests.AssertHTTPErrorCode(t, err, http.StatusForbidden)
+}
@@ -1,10 +1,53 @@
 package middleware
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"net/http"
+	"slices"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/htmx"
+	"github.com/mikestefanello/pagoda/pkg/log"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+// CachedPageGroup is the cache group that cached pages are stored in.
+// All cached pages can be flushed at once by flushing the entire group.
+const CachedPageGroup = "page"
+
+// cachedPageSkipHeaders contains response headers that are never stored with a cached page.
+var cachedPageSkipHeaders = []string{
+	echo.HeaderSetCookie,
+	echo.HeaderContentLength,
+	echo.HeaderContentEncoding,
+	echo.HeaderVary,
+}
+
+type (
+	// CachedPage is what is stored in the cache for a rendered page.
+	CachedPage struct {
+		// URL stores the URL of the requested page.
+		URL string
+
+		// StatusCode stores the HTTP status code of the response.
+		StatusCode int
+
+		// Headers stores the headers that were set while rendering the page.
+		Headers map[string][]string
+
+		// Body stores the rendered page.
+		Body []byte
+	}
+
+	// cachedPageWriter captures the body of a response while it is being written.
+	cachedPageWriter struct {
+		http.ResponseWriter
+		body bytes.Buffer
+	}
 )
 
 // CacheControl sets a Cache-Control header with a given max age.
@@ -20,3 +63,144 @@ func CacheControl(maxAge time.Duration) echo.MiddlewareFunc {
 		}
 	}
 }
+
+// ServeCachedPage attempts to load a page from the cache that matches the requested URL and HTMX request headers.
+// If a page is cached, it will be served here and the request terminated.
+// Any request made by an authenticated user or that is not a GET will be skipped.
+func ServeCachedPage(cache *services.CacheClient) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if !cacheablePageRequest(ctx) {
+				return next(ctx)
+			}
+
+			page, err := services.Cached[CachedPage](cache).
+				Group(CachedPageGroup).
+				Key(cachedPageKey(ctx)).
+				Fetch(ctx.Request().Context())
+
+			if err != nil {
+				switch {
+				case errors.Is(err, services.ErrCacheMiss):
+				case context.IsCanceledError(err):
+					return nil
+				default:
+					log.Ctx(ctx).Error("failed getting cached page",
+						"error", err,
+					)
+				}
+
+				return next(ctx)
+			}
+
+			for k, v := range page.Headers {
+				ctx.Response().Header()[k] = v
+			}
+
+			log.Ctx(ctx).Debug("serving cached page")
+
+			ctx.Response().WriteHeader(page.StatusCode)
+			_, err = ctx.Response().Write(page.Body)
+			return err
+		}
+	}
+}
+
+// CachePage stores the rendered response of a page in the cache so that it can be served by ServeCachedPage,
+// for a given duration and with optional cache tags, which can be flushed when the page's data changes.
+// Only successful responses to GET requests made by users that are not authenticated will be cached. Responses
+// that set a cookie are specific to the visitor and will not be cached.
+// Since every visitor will receive the same page, this should not be used on pages that contain forms, as the
+// CSRF token would be cached along with the page.
+func CachePage(cache *services.CacheClient, expiration time.Duration, tags ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if !cacheablePageRequest(ctx) {
+				return next(ctx)
+			}
+
+			// Track the headers set prior to rendering the page, such as those set by other middleware, so that only
+			// the headers set by the handler are cached.
+			res := ctx.Response()
+			before := res.Header().Clone()
+			w := &cachedPageWriter{ResponseWriter: res.Writer}
+			res.Writer = w
+
+			err := next(ctx)
+			res.Writer = w.ResponseWriter
+
+			if err != nil || !res.Committed || res.Status < 200 || res.Status >= 300 {
+				return err
+			}
+
+			if len(res.Header().Values(echo.HeaderSetCookie)) > len(before.Values(echo.HeaderSetCookie)) {
+				return nil
+			}
+
+			page := CachedPage{
+				URL:        ctx.Request().URL.String(),
+				StatusCode: res.Status,
+				Headers:    make(map[string][]string),
+				Body:       w.body.Bytes(),
+			}
+
+			for k, v := range res.Header() {
+				if !slices.Contains(cachedPageSkipHeaders, k) && !slices.Equal(before[k], v) {
+					page.Headers[k] = v
+				}
+			}
+
+			err = cache.
+				Set().
+				Group(CachedPageGroup).
+				Key(cachedPageKey(ctx)).
+				Data(page).
+				Expiration(expiration).
+				Tags(tags...).
+				Save(ctx.Request().Context())
+
+			if err != nil {
+				log.Ctx(ctx).Error("failed to cache page",
+					"error", err,
+				)
+			}
+
+			return nil
+		}
+	}
+}
+
+// cacheablePageRequest determines if the page being requested can be served from or stored in the cache.
+func cacheablePageRequest(ctx echo.Context) bool {
+	return ctx.Request().Method == http.MethodGet && ctx.Get(context.AuthenticatedUserKey) == nil
+}
+
+// cachedPageKey returns the cache key of the requested page.
+// Since HTMX requests can be rendered without the layout, or vary based on what triggered them, the HTMX request
+// headers are included in the key.
+func cachedPageKey(ctx echo.Context) string {
+	key := ctx.Request().URL.RequestURI()
+
+	if h := htmx.GetRequest(ctx); h.Enabled {
+		key = fmt.Sprintf("%s|htmx|%t|%t|%s|%s|%s",
+			key,
+			h.Boosted,
+			h.HistoryRestore,
+			h.Target,
+			h.Trigger,
+			h.TriggerName,
+		)
+	}
+
+	return key
+}
+
+func (w *cachedPageWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the original writer, which is required by http.ResponseController.
+func (w *cachedPageWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
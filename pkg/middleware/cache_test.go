@@ -1,12 +1,19 @@
 package middleware
 
 import (
+	goctx "context"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/htmx"
+	"github.com/mikestefanello/pagoda/pkg/services"
 	"github.com/mikestefanello/pagoda/pkg/tests"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCacheControl(t *testing.T) {
@@ -16,3 +23,88 @@ func TestCacheControl(t *testing.T) {
 	_ = tests.ExecuteMiddleware(ctx, CacheControl(0))
 	assert.Equal(t, "no-cache, no-store", ctx.Response().Header().Get("Cache-Control"))
 }
+
+func TestCachePage(t *testing.T) {
+	url := "/cache-page-test?page=1"
+	handler := func(ctx echo.Context) error {
+		ctx.Response().Header().Set("X-Test", "abc")
+		return ctx.HTML(http.StatusCreated, "<p>page</p>")
+	}
+
+	// Cache the page
+	ctx, rec := tests.NewContext(c.Web, url)
+	err := tests.ExecuteHandler(ctx, handler, CachePage(c.Cache, time.Minute, "page-tag"))
+	require.NoError(t, err)
+	assert.Equal(t, "<p>page</p>", rec.Body.String())
+
+	page, err := services.Cached[CachedPage](c.Cache).
+		Group(CachedPageGroup).
+		Key(url).
+		Fetch(goctx.Background())
+	require.NoError(t, err)
+	assert.Equal(t, url, page.URL)
+	assert.Equal(t, http.StatusCreated, page.StatusCode)
+	assert.Equal(t, []string{"abc"}, page.Headers["X-Test"])
+	assert.Equal(t, []byte("<p>page</p>"), page.Body)
+
+	// Serve the cached page
+	ctx, rec = tests.NewContext(c.Web, url)
+	err = tests.ExecuteMiddleware(ctx, ServeCachedPage(c.Cache))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "abc", rec.Header().Get("X-Test"))
+	assert.Equal(t, "<p>page</p>", rec.Body.String())
+
+	// HTMX requests are cached separately
+	ctx, rec = tests.NewContext(c.Web, url)
+	ctx.Request().Header.Set(htmx.HeaderRequest, "true")
+	err = tests.ExecuteMiddleware(ctx, ServeCachedPage(c.Cache))
+	require.NoError(t, err)
+	assert.Empty(t, rec.Body.String())
+
+	// Authenticated users are not served cached pages
+	ctx, rec = tests.NewContext(c.Web, url)
+	ctx.Set(context.AuthenticatedUserKey, usr)
+	err = tests.ExecuteMiddleware(ctx, ServeCachedPage(c.Cache))
+	require.NoError(t, err)
+	assert.Empty(t, rec.Body.String())
+
+	// Flush the page by its tag
+	err = c.Cache.
+		Flush().
+		Tags("page-tag").
+		Execute(goctx.Background())
+	require.NoError(t, err)
+
+	ctx, rec = tests.NewContext(c.Web, url)
+	err = tests.ExecuteMiddleware(ctx, ServeCachedPage(c.Cache))
+	require.NoError(t, err)
+	assert.Empty(t, rec.Body.String())
+}
+
+func TestCachePage_Skip(t *testing.T) {
+	assertNotCached := func(url string, handler echo.HandlerFunc) {
+		ctx, _ := tests.NewContext(c.Web, url)
+		_ = tests.ExecuteHandler(ctx, handler, CachePage(c.Cache, time.Minute))
+
+		_, err := services.Cached[CachedPage](c.Cache).
+			Group(CachedPageGroup).
+			Key(url).
+			Fetch(goctx.Background())
+		assert.Equal(t, services.ErrCacheMiss, err)
+	}
+
+	// Unsuccessful responses
+	assertNotCached("/cache-page-error", func(ctx echo.Context) error {
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	})
+	assertNotCached("/cache-page-not-found", func(ctx echo.Context) error {
+		return ctx.HTML(http.StatusNotFound, "not found")
+	})
+
+	// Responses that set a cookie
+	assertNotCached("/cache-page-cookie", func(ctx echo.Context) error {
+		ctx.SetCookie(&http.Cookie{Name: "test", Value: "abc"})
+		return ctx.HTML(http.StatusOK, "<p>page</p>")
+	})
+}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/htmx"
+	"github.com/mikestefanello/pagoda/pkg/log"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+// RateLimitKey returns the key that a request is rate limited by. If the key is empty, the request is not limited.
+type RateLimitKey func(ctx echo.Context) string
+
+// RateLimitByIP rate limits requests by the IP address of the client.
+func RateLimitByIP(ctx echo.Context) string {
+	return "ip:" + ctx.RealIP()
+}
+
+// RateLimitByUser rate limits requests by the authenticated user, falling back to the IP address of the client
+// if the user is not authenticated.
+func RateLimitByUser(ctx echo.Context) string {
+	if u, ok := ctx.Get(context.AuthenticatedUserKey).(*ent.User); ok {
+		return fmt.Sprintf("user:%d", u.ID)
+	}
+	return RateLimitByIP(ctx)
+}
+
+// RateLimitByFormField rate limits requests by the value of a given form field, such as an email address.
+// This prevents a single account from being targeted by many clients. Requests where the field is empty are not
+// limited by it, so this should be combined with another key.
+func RateLimitByFormField(field string) RateLimitKey {
+	return func(ctx echo.Context) string {
+		if v := strings.ToLower(strings.TrimSpace(ctx.FormValue(field))); v != "" {
+			return fmt.Sprintf("field:%s:%s", field, v)
+		}
+		return ""
+	}
+}
+
+// RateLimit limits how many requests can be made within the window of a given rule, per key. The name separates
+// the limits of different actions and keys, so each limit should have its own. Once the limit has been reached, a 429 error is returned
+// along with a Retry-After header. For HTMX requests which target an element, the error is inserted at the top of
+// the triggering element, such as a form, rather than replacing the page. If the limiter fails, the request is
+// allowed.
+func RateLimit(limiter *services.RateLimiter, name string, rule config.RateLimitRule, key RateLimitKey) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			k := key(ctx)
+			if k == "" {
+				return next(ctx)
+			}
+
+			res, err := limiter.Allow(
+				ctx.Request().Context(),
+				fmt.Sprintf("%s:%s", name, k),
+				rule.Limit,
+				rule.Window,
+			)
+
+			switch {
+			case err != nil:
+				log.Ctx(ctx).Error("rate limiter failed",
+					"name", name,
+					"error", err,
+				)
+				return next(ctx)
+			case res.Allowed:
+				return next(ctx)
+			}
+
+			retryAfter := int(math.Ceil(res.RetryAfter.Seconds()))
+			ctx.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(retryAfter))
+
+			if r := htmx.GetRequest(ctx); r.Enabled && !r.Boosted && r.Trigger != "" {
+				htmx.Response{
+					Retarget: "#" + r.Trigger,
+					Reswap:   "afterbegin",
+				}.Apply(ctx)
+			}
+
+			return echo.NewHTTPError(
+				http.StatusTooManyRequests,
+				fmt.Sprintf("rate limit exceeded: %s", name),
+			)
+		}
+	}
+}
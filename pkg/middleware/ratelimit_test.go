@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/htmx"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit(t *testing.T) {
+	rule := config.RateLimitRule{Limit: 2, Window: time.Minute}
+	mw := RateLimit(c.RateLimiter, "test", rule, RateLimitByIP)
+
+	newContext := func() echo.Context {
+		ctx, _ := tests.NewContext(c.Web, "/")
+		ctx.Request().RemoteAddr = "10.0.0.1:1234"
+		return ctx
+	}
+
+	for range rule.Limit {
+		err := tests.ExecuteMiddleware(newContext(), mw)
+		require.NoError(t, err)
+	}
+
+	ctx := newContext()
+	err := tests.ExecuteMiddleware(ctx, mw)
+	tests.AssertHTTPErrorCode(t, err, http.StatusTooManyRequests)
+	assert.Equal(t, "30", ctx.Response().Header().Get(echo.HeaderRetryAfter))
+	assert.Empty(t, ctx.Response().Header().Get(htmx.HeaderRetarget))
+
+	// HTMX requests insert the error in to the triggering element
+	ctx = newContext()
+	ctx.Request().Header.Set(htmx.HeaderRequest, "true")
+	ctx.Request().Header.Set(htmx.HeaderTrigger, "contact")
+	err = tests.ExecuteMiddleware(ctx, mw)
+	tests.AssertHTTPErrorCode(t, err, http.StatusTooManyRequests)
+	assert.Equal(t, "#contact", ctx.Response().Header().Get(htmx.HeaderRetarget))
+	assert.Equal(t, "afterbegin", ctx.Response().Header().Get(htmx.HeaderReswap))
+
+	// Other clients are not affected
+	ctx = newContext()
+	ctx.Request().RemoteAddr = "10.0.0.2:1234"
+	err = tests.ExecuteMiddleware(ctx, mw)
+	assert.NoError(t, err)
+
+	// Requests with an empty key are not limited
+	mw = RateLimit(c.RateLimiter, "test", rule, func(echo.Context) string { return "" })
+	for range rule.Limit + 1 {
+		err = tests.ExecuteMiddleware(newContext(), mw)
+		require.NoError(t, err)
+	}
+}
+
+func TestRateLimitKeys(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	ctx.Request().RemoteAddr = "10.0.0.1:1234"
+	assert.Equal(t, "ip:10.0.0.1", RateLimitByIP(ctx))
+	assert.Equal(t, "ip:10.0.0.1", RateLimitByUser(ctx))
+	assert.Empty(t, RateLimitByFormField("email")(ctx))
+
+	ctx.Set(context.AuthenticatedUserKey, usr)
+	assert.Equal(t, "user:"+strconv.Itoa(usr.ID), RateLimitByUser(ctx))
+
+	form := url.Values{"email": {" A@Example.com "}}
+	ctx, _ = tests.NewContext(c.Web, "/")
+	ctx.Request().Method = http.MethodPost
+	ctx.Request().Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+	ctx.Request().Body = io.NopCloser(strings.NewReader(form.Encode()))
+	assert.Equal(t, "field:email:a@example.com", RateLimitByFormField("email")(ctx))
+}
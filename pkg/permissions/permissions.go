@@ -0,0 +1,68 @@
+package permissions
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// ActionList is the action of listing entities in the admin panel.
+	ActionList = "list"
+
+	// ActionAdd is the action of adding entities in the admin panel.
+	ActionAdd = "add"
+
+	// ActionEdit is the action of editing entities in the admin panel.
+	ActionEdit = "edit"
+
+	// ActionDelete is the action of deleting entities in the admin panel.
+	ActionDelete = "delete"
+)
+
+// InviteUsers is the permission to invite people to register, which is required when registration is invite-only.
+const InviteUsers = "users.invite"
+
+// EntityActions contains every action that can be performed on entities in the admin panel.
+var EntityActions = []string{ActionList, ActionAdd, ActionEdit, ActionDelete}
+
+// adminEntities contains the entity types which only admins can manage in the admin panel, since whoever manages
+// them controls the permissions of every user, including their own, or can log in as any user.
+var adminEntities = []string{
+	// Permissions.
+	"Role", "Permission", "UserRole", "RolePermission",
+	// Credentials.
+	"APIToken", "MagicLink", "PasswordToken", "RecoveryCode", "RememberToken", "Session", "UserIdentity",
+	"WebAuthnCredential",
+}
+
+// readOnlyEntities contains the entity types which can only be listed in the admin panel, such as audit logs, which
+// must not be changed by anyone, including the admins whose actions they record.
+var readOnlyEntities = []string{"Impersonation"}
+
+// AdminOnly returns true if a given entity type can only be managed by admins in the admin panel, in which case there
+// are no permissions to manage it.
+func AdminOnly(entityTypeName string) bool {
+	return slices.Contains(adminEntities, entityTypeName)
+}
+
+// Entity returns the name of the permission to perform a given action on a given entity type in the admin panel,
+// such as "entity.user.edit".
+func Entity(entityTypeName, action string) string {
+	return fmt.Sprintf("entity.%s.%s", strings.ToLower(entityTypeName), action)
+}
+
+// EntityActionsOf returns the actions that can be performed on a given entity type in the admin panel, which is only
+// listing for read-only entity types.
+func EntityActionsOf(entityTypeName string) []string {
+	if slices.Contains(readOnlyEntities, entityTypeName) {
+		return []string{ActionList}
+	}
+	return EntityActions
+}
+
+// CanEntity returns true if a given action can be performed on a given entity type in the admin panel, regardless
+// of who performs it.
+func CanEntity(entityTypeName, action string) bool {
+	return slices.Contains(EntityActionsOf(entityTypeName), action)
+}
@@ -11,12 +11,45 @@ const (
 	ContactSubmit        = "contact.submit"
 	Login                = "login"
 	LoginSubmit          = "login.submit"
+	LoginVerify          = "login_verify"
+	LoginVerifySubmit    = "login_verify.submit"
+	MagicLink            = "magic_link"
+	MagicLinkSubmit      = "magic_link.submit"
+	MagicLinkLogin       = "magic_link.login"
+	PasskeyLogin         = "passkey_login"
+	PasskeyLoginSubmit   = "passkey_login.submit"
+	OAuthLogin           = "oauth_login"
+	OAuthCallback        = "oauth_login.callback"
 	Register             = "register"
 	RegisterSubmit       = "register.submit"
 	ForgotPassword       = "forgot_password"
 	ForgotPasswordSubmit = "forgot_password.submit"
 	Logout               = "logout"
 	VerifyEmail          = "verify_email"
+	VerifyEmailNotice    = "verify_email.notice"
+	VerifyEmailResend    = "verify_email.resend"
+	UnlockAccount        = "unlock_account"
+	TwoFactor            = "two_factor"
+	TwoFactorEnable      = "two_factor.enable"
+	TwoFactorDisable     = "two_factor.disable"
+	Passkeys             = "passkeys"
+	PasskeyRegister      = "passkeys.register"
+	PasskeyCreate        = "passkeys.create"
+	PasskeyDelete        = "passkeys.delete"
+	Sessions             = "sessions"
+	SessionRevoke        = "sessions.revoke"
+	APITokens            = "api_tokens"
+	APITokenCreate       = "api_tokens.create"
+	APITokenRevoke       = "api_tokens.revoke"
+	Invitations          = "invitations"
+	InvitationCreate     = "invitations.create"
+	InvitationRevoke     = "invitations.revoke"
+	Settings             = "settings"
+	SettingsName         = "settings.name"
+	SettingsEmail        = "settings.email"
+	SettingsPassword     = "settings.password"
+	SettingsDelete       = "settings.delete"
+	ChangeEmail          = "change_email"
 	ResetPassword        = "reset_password"
 	ResetPasswordSubmit  = "reset_password.submit"
 	Search               = "search"
@@ -27,6 +60,10 @@ const (
 	Files                = "files"
 	FilesSubmit          = "files.submit"
 	AdminTasks           = "admin:tasks"
+	AdminCache           = "admin:cache"
+	AdminCacheSubmit     = "admin:cache.submit"
+	AdminImpersonate     = "admin:impersonate"
+	AdminImpersonateStop = "admin:impersonate.stop"
 )
 
 func AdminEntityList(entityTypeName string) string {
@@ -1,17 +1,29 @@
 package services
 
 import (
+	goctx "context"
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"time"
 
+	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/mikestefanello/pagoda/config"
 	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/apitoken"
+	"github.com/mikestefanello/pagoda/ent/impersonation"
+	"github.com/mikestefanello/pagoda/ent/loginattempt"
+	"github.com/mikestefanello/pagoda/ent/magiclink"
 	"github.com/mikestefanello/pagoda/ent/passwordtoken"
+	"github.com/mikestefanello/pagoda/ent/recoverycode"
+	"github.com/mikestefanello/pagoda/ent/remembertoken"
+	entsession "github.com/mikestefanello/pagoda/ent/session"
 	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/ent/useridentity"
+	"github.com/mikestefanello/pagoda/ent/userrole"
+	"github.com/mikestefanello/pagoda/ent/webauthncredential"
 	"github.com/mikestefanello/pagoda/pkg/context"
 	"github.com/mikestefanello/pagoda/pkg/session"
 
@@ -38,6 +50,15 @@ func (e NotAuthenticatedError) Error() string {
 	return "user not authenticated"
 }
 
+// UnverifiedError is an error returned when a user cannot log in because they have not verified their email address
+// within the grace period in configuration.
+type UnverifiedError struct{}
+
+// Error implements the error interface.
+func (e UnverifiedError) Error() string {
+	return "email address not verified"
+}
+
 // InvalidPasswordTokenError is an error returned when an invalid token is provided
 type InvalidPasswordTokenError struct{}
 
@@ -48,16 +69,25 @@ func (e InvalidPasswordTokenError) Error() string {
 
 // AuthClient is the client that handles authentication requests
 type AuthClient struct {
-	config *config.Config
-	orm    *ent.Client
+	config   *config.Config
+	orm      *ent.Client
+	oauth    map[string]*OAuthProvider
+	webauthn *webauthn.WebAuthn
 }
 
 // NewAuthClient creates a new authentication client
-func NewAuthClient(cfg *config.Config, orm *ent.Client) *AuthClient {
-	return &AuthClient{
-		config: cfg,
-		orm:    orm,
+func NewAuthClient(cfg *config.Config, orm *ent.Client) (*AuthClient, error) {
+	wa, err := newWebAuthn(cfg)
+	if err != nil {
+		return nil, err
 	}
+
+	return &AuthClient{
+		config:   cfg,
+		orm:      orm,
+		oauth:    newOAuthProviders(cfg),
+		webauthn: wa,
+	}, nil
 }
 
 // Login logs in a user of a given ID
@@ -66,35 +96,81 @@ func (c *AuthClient) Login(ctx echo.Context, userID int) error {
 	if err != nil {
 		return err
 	}
+
+	// Discard the session ID issued prior to logging in, if any, so that it cannot be used to hijack the session.
+	if sess.ID != "" {
+		_, err = c.orm.Session.
+			Delete().
+			Where(entsession.Token(hashSessionID(sess.ID))).
+			Exec(ctx.Request().Context())
+		if err != nil {
+			return err
+		}
+		sess.ID = ""
+	}
+
 	sess.Values[authSessionKeyUserID] = userID
 	sess.Values[authSessionKeyAuthenticated] = true
+	delete(sess.Values, authSessionKeyImpersonatorID)
+	delete(sess.Values, authSessionKeyPendingUserID)
+	delete(sess.Values, authSessionKeyPendingAt)
+	delete(sess.Values, authSessionKeyPendingRemember)
 	return sess.Save(ctx.Request(), ctx.Response())
 }
 
-// Logout logs the requesting user out
+// Logout logs the requesting user out, and forgets them if they asked to be remembered. Logging out while
+// impersonating a user is recorded as the admin having stopped impersonating them.
 func (c *AuthClient) Logout(ctx echo.Context) error {
+	if err := c.Forget(ctx); err != nil {
+		return err
+	}
+
 	sess, err := session.Get(ctx, authSessionName)
 	if err != nil {
 		return err
 	}
+
+	if admin, usr, err := c.getImpersonation(ctx, sess.Values); err == nil {
+		if err = c.recordImpersonation(ctx, impersonation.ActionStop, admin, usr); err != nil {
+			return err
+		}
+	}
+
 	sess.Values[authSessionKeyAuthenticated] = false
+	delete(sess.Values, authSessionKeyImpersonatorID)
+	delete(sess.Values, authSessionKeyPendingUserID)
+	delete(sess.Values, authSessionKeyPendingAt)
+	delete(sess.Values, authSessionKeyPendingRemember)
+
+	// Remove the session entirely so that it cannot be used again.
+	sess.Options.MaxAge = -1
 	return sess.Save(ctx.Request(), ctx.Response())
 }
 
-// GetAuthenticatedUserID returns the authenticated user's ID, if the user is logged in
+// GetAuthenticatedUserID returns the authenticated user's ID, if the user is logged in.
+// Sessions which are still pending the second factor are not authenticated.
 func (c *AuthClient) GetAuthenticatedUserID(ctx echo.Context) (int, error) {
 	sess, err := session.Get(ctx, authSessionName)
 	if err != nil {
 		return 0, err
 	}
 
-	if sess.Values[authSessionKeyAuthenticated] == true {
-		return sess.Values[authSessionKeyUserID].(int), nil
+	if userID, ok := authenticatedUserID(sess.Values); ok {
+		return userID, nil
 	}
 
 	return 0, NotAuthenticatedError{}
 }
 
+// authenticatedUserID returns the ID of the user that given session values authenticate, if any.
+func authenticatedUserID(values map[any]any) (int, bool) {
+	if values[authSessionKeyAuthenticated] == true && values[authSessionKeyPendingUserID] == nil {
+		userID, ok := values[authSessionKeyUserID].(int)
+		return userID, ok
+	}
+	return 0, false
+}
+
 // GetAuthenticatedUser returns the authenticated user if the user is logged in
 func (c *AuthClient) GetAuthenticatedUser(ctx echo.Context) (*ent.User, error) {
 	if userID, err := c.GetAuthenticatedUserID(ctx); err == nil {
@@ -174,6 +250,49 @@ func (c *AuthClient) DeletePasswordTokens(ctx echo.Context, userID int) error {
 	return err
 }
 
+// DeleteUser deletes a given user, along with everything in the database belonging to them, such as their password
+// tokens and sessions.
+func (c *AuthClient) DeleteUser(ctx echo.Context, userID int) error {
+	reqCtx := ctx.Request().Context()
+
+	tx, err := c.orm.Tx(reqCtx)
+	if err != nil {
+		return err
+	}
+
+	err = func() error {
+		deletes := []interface {
+			Exec(goctx.Context) (int, error)
+		}{
+			tx.PasswordToken.Delete().Where(passwordtoken.UserID(userID)),
+			tx.LoginAttempt.Delete().Where(loginattempt.UserID(userID)),
+			tx.RecoveryCode.Delete().Where(recoverycode.UserID(userID)),
+			tx.Session.Delete().Where(entsession.UserID(userID)),
+			tx.RememberToken.Delete().Where(remembertoken.UserID(userID)),
+			tx.UserIdentity.Delete().Where(useridentity.UserID(userID)),
+			tx.MagicLink.Delete().Where(magiclink.UserID(userID)),
+			tx.UserRole.Delete().Where(userrole.UserID(userID)),
+			tx.APIToken.Delete().Where(apitoken.UserID(userID)),
+			tx.WebAuthnCredential.Delete().Where(webauthncredential.UserID(userID)),
+		}
+
+		for _, d := range deletes {
+			if _, err := d.Exec(reqCtx); err != nil {
+				return err
+			}
+		}
+
+		return tx.User.DeleteOneID(userID).Exec(reqCtx)
+	}()
+
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
 // RandomToken generates a random token string of a given length
 func (c *AuthClient) RandomToken(length int) (string, error) {
 	b := make([]byte, (length/2)+1)
@@ -211,8 +330,21 @@ func (c *AuthClient) ValidateEmailVerificationToken(token string) (string, error
 	}
 
 	if claims, ok := t.Claims.(jwt.MapClaims); ok && t.Valid {
-		return claims["email"].(string), nil
+		if email, ok := claims["email"].(string); ok {
+			return email, nil
+		}
 	}
 
 	return "", errors.New("invalid or expired token")
 }
+
+// CheckVerified checks if a given user can log in, which requires that they have verified their email address once
+// the grace period in configuration has passed since they registered. An UnverifiedError is returned if not.
+func (c *AuthClient) CheckVerified(usr *ent.User) error {
+	grace := c.config.App.UnverifiedLoginGracePeriod
+	if usr.Verified || grace <= 0 || time.Since(usr.CreatedAt) < grace {
+		return nil
+	}
+
+	return UnverifiedError{}
+}
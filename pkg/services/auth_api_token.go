@@ -0,0 +1,140 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/apitoken"
+)
+
+const (
+	// APITokenScopeRead allows API tokens to make requests which do not change anything, such as GET requests
+	APITokenScopeRead = "read"
+
+	// APITokenScopeWrite allows API tokens to make requests which can change things, such as POST requests
+	APITokenScopeWrite = "write"
+
+	// apiTokenLength stores the length of generated API tokens
+	apiTokenLength = 48
+
+	// apiTokenLastUsedInterval stores how often the time API tokens were last used at is updated, so that requests
+	// do not all have to write to the database
+	apiTokenLastUsedInterval = time.Minute
+)
+
+// APITokenScopes stores all scopes that API tokens can be granted.
+var APITokenScopes = []string{APITokenScopeRead, APITokenScopeWrite}
+
+// InvalidAPITokenError is an error returned when an API token is invalid or has expired.
+type InvalidAPITokenError struct{}
+
+// Error implements the error interface.
+func (e InvalidAPITokenError) Error() string {
+	return "invalid api token"
+}
+
+// CreateAPIToken creates a personal access token for a given user, which machine clients can authenticate requests
+// with instead of a session. Like password tokens, only a hash of the token is stored, so the token that is returned
+// cannot be retrieved again. Tokens do not expire if the expiration is zero.
+func (c *AuthClient) CreateAPIToken(
+	ctx echo.Context,
+	userID int,
+	name string,
+	scopes []string,
+	expiresAt time.Time,
+) (string, *ent.APIToken, error) {
+	token, err := c.RandomToken(apiTokenLength)
+	if err != nil {
+		return "", nil, err
+	}
+
+	t, err := c.orm.APIToken.
+		Create().
+		SetUserID(userID).
+		SetName(name).
+		SetToken(hashAPIToken(token)).
+		SetScopes(scopes).
+		SetExpiresAt(expiresAt).
+		Save(ctx.Request().Context())
+	if err != nil {
+		return "", nil, err
+	}
+
+	return token, t, nil
+}
+
+// GetAPITokens returns the API tokens of a given user, newest first.
+func (c *AuthClient) GetAPITokens(ctx echo.Context, userID int) ([]*ent.APIToken, error) {
+	return c.orm.APIToken.
+		Query().
+		Where(apitoken.UserID(userID)).
+		Order(ent.Desc(apitoken.FieldCreatedAt)).
+		All(ctx.Request().Context())
+}
+
+// RevokeAPIToken revokes a given API token of a given user, so it can no longer be used.
+func (c *AuthClient) RevokeAPIToken(ctx echo.Context, userID, tokenID int) error {
+	deleted, err := c.orm.APIToken.
+		Delete().
+		Where(
+			apitoken.ID(tokenID),
+			apitoken.UserID(userID),
+		).
+		Exec(ctx.Request().Context())
+
+	switch {
+	case err != nil:
+		return err
+	case deleted == 0:
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+}
+
+// GetAPIToken returns the API token matching a given token, with its user loaded, if it has not expired, and records
+// that it was used. An InvalidAPITokenError is returned otherwise.
+func (c *AuthClient) GetAPIToken(ctx echo.Context, token string) (*ent.APIToken, error) {
+	t, err := c.orm.APIToken.
+		Query().
+		Where(apitoken.Token(hashAPIToken(token))).
+		WithUser().
+		Only(ctx.Request().Context())
+
+	switch {
+	case ent.IsNotFound(err):
+		return nil, InvalidAPITokenError{}
+	case err != nil:
+		return nil, err
+	case !t.ExpiresAt.IsZero() && time.Now().After(t.ExpiresAt):
+		return nil, InvalidAPITokenError{}
+	}
+
+	if time.Since(t.LastUsedAt) > apiTokenLastUsedInterval {
+		err = c.orm.APIToken.
+			UpdateOne(t).
+			SetLastUsedAt(time.Now()).
+			Exec(ctx.Request().Context())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
+
+// HasAPITokenScope determines if a given API token has been granted a given scope.
+func HasAPITokenScope(t *ent.APIToken, scope string) bool {
+	return slices.Contains(t.Scopes, scope)
+}
+
+// hashAPIToken hashes an API token for storage. Since tokens are long and random, a fast hash is sufficient and
+// allows tokens to be looked up by their hash.
+func hashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_APIToken(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+
+	token, created, err := c.Auth.CreateAPIToken(ctx, u.ID, "Test", []string{APITokenScopeRead}, time.Time{})
+	require.NoError(t, err)
+	assert.Len(t, token, apiTokenLength)
+
+	// Only a hash of the token is stored
+	assert.NotEqual(t, token, created.Token)
+
+	got, err := c.Auth.GetAPIToken(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, got.ID)
+	assert.Equal(t, u.ID, got.Edges.User.ID)
+	assert.True(t, HasAPITokenScope(got, APITokenScopeRead))
+	assert.False(t, HasAPITokenScope(got, APITokenScopeWrite))
+
+	// Using the token records when it was used
+	got, err = c.ORM.APIToken.Get(ctx.Request().Context(), created.ID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), got.LastUsedAt, time.Minute)
+
+	// Unknown tokens are rejected
+	_, err = c.Auth.GetAPIToken(ctx, "invalid")
+	assert.Equal(t, InvalidAPITokenError{}, err)
+
+	// As are expired tokens
+	expired, _, err := c.Auth.CreateAPIToken(ctx, u.ID, "Expired", APITokenScopes, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	_, err = c.Auth.GetAPIToken(ctx, expired)
+	assert.Equal(t, InvalidAPITokenError{}, err)
+
+	tokens, err := c.Auth.GetAPITokens(ctx, u.ID)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+
+	// Tokens can only be revoked by their user
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	err = c.Auth.RevokeAPIToken(ctx, other.ID, created.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	err = c.Auth.RevokeAPIToken(ctx, u.ID, created.ID)
+	require.NoError(t, err)
+	_, err = c.Auth.GetAPIToken(ctx, token)
+	assert.Equal(t, InvalidAPITokenError{}, err)
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/user"
+)
+
+// emailChangeAudience stores the audience of email change tokens, which distinguishes them from other tokens signed
+// with the same key
+const emailChangeAudience = "email_change"
+
+// InvalidEmailChangeError is an error returned when an email change token is invalid, has expired or no longer
+// applies because the email address of the user has since changed.
+type InvalidEmailChangeError struct{}
+
+// Error implements the error interface.
+func (e InvalidEmailChangeError) Error() string {
+	return "invalid email change token"
+}
+
+// EmailTakenError is an error returned when changing the email address of a user to one which belongs to another
+// user.
+type EmailTakenError struct {
+	Email string
+}
+
+// Error implements the error interface.
+func (e EmailTakenError) Error() string {
+	return fmt.Sprintf("email address %s is already in use", e.Email)
+}
+
+// EmailChange is a requested change of the email address of a user.
+type EmailChange struct {
+	UserID   int
+	OldEmail string
+	NewEmail string
+}
+
+// emailChangeClaims are the claims of email change tokens.
+type emailChangeClaims struct {
+	jwt.RegisteredClaims
+	OldEmail string `json:"old_email"`
+	NewEmail string `json:"new_email"`
+}
+
+// GenerateEmailChangeToken generates a token which confirms a given change of the email address of a user, using JWT
+// which is set to expire based on the email verification token duration stored in configuration. Since it is sent
+// to the new email address, confirming it also verifies that address.
+func (c *AuthClient) GenerateEmailChangeToken(change EmailChange) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, emailChangeClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   strconv.Itoa(change.UserID),
+			Audience:  jwt.ClaimStrings{emailChangeAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(c.config.App.EmailVerificationTokenExpiration)),
+		},
+		OldEmail: change.OldEmail,
+		NewEmail: change.NewEmail,
+	})
+
+	return token.SignedString([]byte(c.config.App.EncryptionKey))
+}
+
+// ChangeEmail validates an email change token and applies the change it confirms, which also marks the user as
+// verified. Tokens only apply while the user still has the email address they were generated for, so they cannot be
+// used to undo later changes. An InvalidEmailChangeError is returned if the token is not valid, and an
+// EmailTakenError is returned if another user has since taken the new email address.
+func (c *AuthClient) ChangeEmail(ctx echo.Context, token string) (*EmailChange, error) {
+	var claims emailChangeClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+
+		return []byte(c.config.App.EncryptionKey), nil
+	},
+		jwt.WithAudience(emailChangeAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, InvalidEmailChangeError{}
+	}
+
+	userID, err := strconv.Atoi(claims.Subject)
+	if err != nil || claims.OldEmail == "" || claims.NewEmail == "" {
+		return nil, InvalidEmailChangeError{}
+	}
+
+	updated, err := c.orm.User.
+		Update().
+		Where(
+			user.ID(userID),
+			user.Email(claims.OldEmail),
+		).
+		SetEmail(claims.NewEmail).
+		SetVerified(true).
+		Save(ctx.Request().Context())
+
+	switch {
+	case ent.IsConstraintError(err):
+		return nil, EmailTakenError{Email: claims.NewEmail}
+	case err != nil:
+		return nil, err
+	case updated == 0:
+		return nil, InvalidEmailChangeError{}
+	}
+
+	return &EmailChange{
+		UserID:   userID,
+		OldEmail: claims.OldEmail,
+		NewEmail: claims.NewEmail,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/mikestefanello/pagoda/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_ChangeEmail(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+
+	change := EmailChange{
+		UserID:   u.ID,
+		OldEmail: u.Email,
+		NewEmail: "changed-" + u.Email,
+	}
+	token, err := c.Auth.GenerateEmailChangeToken(change)
+	require.NoError(t, err)
+
+	// Tampered tokens are rejected
+	_, err = c.Auth.ChangeEmail(ctx, token[:len(token)-2])
+	assert.Equal(t, InvalidEmailChangeError{}, err)
+
+	// As are other tokens signed with the same key
+	verification, err := c.Auth.GenerateEmailVerificationToken(change.NewEmail)
+	require.NoError(t, err)
+	_, err = c.Auth.ChangeEmail(ctx, verification)
+	assert.Equal(t, InvalidEmailChangeError{}, err)
+	_, err = c.Auth.ValidateEmailVerificationToken(token)
+	assert.Error(t, err)
+
+	// The change applies on confirmation, and verifies the new address
+	got, err := c.Auth.ChangeEmail(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, change, *got)
+
+	u, err = c.ORM.User.Get(ctx.Request().Context(), u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, change.NewEmail, u.Email)
+	assert.True(t, u.Verified)
+
+	// Tokens no longer apply once the email address has changed
+	_, err = c.Auth.ChangeEmail(ctx, token)
+	assert.Equal(t, InvalidEmailChangeError{}, err)
+
+	// Addresses taken by another user cannot be changed to
+	token, err = c.Auth.GenerateEmailChangeToken(EmailChange{
+		UserID:   u.ID,
+		OldEmail: u.Email,
+		NewEmail: other.Email,
+	})
+	require.NoError(t, err)
+	_, err = c.Auth.ChangeEmail(ctx, token)
+	assert.Equal(t, EmailTakenError{Email: other.Email}, err)
+
+	// Changing the email address otherwise requires it to be verified again
+	u, err = u.Update().
+		SetEmail("again-" + u.Email).
+		Save(ctx.Request().Context())
+	require.NoError(t, err)
+	assert.False(t, u.Verified)
+}
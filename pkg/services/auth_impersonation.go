@@ -0,0 +1,155 @@
+package services
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/impersonation"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/pkg/session"
+)
+
+const (
+	// authSessionKeyImpersonatorID stores the key used to store the ID of the admin impersonating the user in the
+	// session
+	authSessionKeyImpersonatorID = "impersonator_id"
+)
+
+// NotImpersonatingError is an error returned when the session is not impersonating a user.
+type NotImpersonatingError struct{}
+
+// Error implements the error interface.
+func (e NotImpersonatingError) Error() string {
+	return "not impersonating a user"
+}
+
+// ImpersonationNotAllowedError is an error returned when a user cannot be impersonated, such as when they are an admin
+// or the session is already impersonating a user, or when the admin impersonating a user is no longer allowed to.
+type ImpersonationNotAllowedError struct{}
+
+// Error implements the error interface.
+func (e ImpersonationNotAllowedError) Error() string {
+	return "impersonation not allowed"
+}
+
+// Impersonate authenticates the session of a given admin as a given user, so the admin can see what the user sees,
+// while remembering the admin so that they can stop impersonating. Each time an admin starts impersonating a user is
+// recorded. Admins cannot be impersonated, which also prevents impersonating another user while already impersonating.
+func (c *AuthClient) Impersonate(ctx echo.Context, admin, usr *ent.User) error {
+	sess, err := session.Get(ctx, authSessionName)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := sess.Values[authSessionKeyImpersonatorID]; ok || usr.Admin || usr.ID == admin.ID {
+		return ImpersonationNotAllowedError{}
+	}
+
+	if err = c.recordImpersonation(ctx, impersonation.ActionStart, admin, usr); err != nil {
+		return err
+	}
+
+	sess.Values[authSessionKeyUserID] = usr.ID
+	sess.Values[authSessionKeyImpersonatorID] = admin.ID
+	return sess.Save(ctx.Request(), ctx.Response())
+}
+
+// StopImpersonating authenticates the session as the admin who was impersonating the user again, and returns the
+// admin. Each time an admin stops impersonating a user is recorded. A NotImpersonatingError is returned if the session
+// is not impersonating a user.
+func (c *AuthClient) StopImpersonating(ctx echo.Context) (*ent.User, error) {
+	sess, err := session.Get(ctx, authSessionName)
+	if err != nil {
+		return nil, err
+	}
+
+	admin, usr, err := c.getImpersonation(ctx, sess.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.recordImpersonation(ctx, impersonation.ActionStop, admin, usr); err != nil {
+		return nil, err
+	}
+
+	sess.Values[authSessionKeyUserID] = admin.ID
+	delete(sess.Values, authSessionKeyImpersonatorID)
+	return admin, sess.Save(ctx.Request(), ctx.Response())
+}
+
+// GetImpersonator returns the admin impersonating the authenticated user. A NotImpersonatingError is returned if the
+// session is not impersonating a user, and an ImpersonationNotAllowedError if the admin has since been deleted or is
+// no longer an admin, in which case the session should be logged out.
+func (c *AuthClient) GetImpersonator(ctx echo.Context) (*ent.User, error) {
+	sess, err := session.Get(ctx, authSessionName)
+	if err != nil {
+		return nil, err
+	}
+
+	admin, _, err := c.getImpersonation(ctx, sess.Values)
+	return admin, err
+}
+
+// getImpersonation returns the admin and the user that given session values are impersonating, if any.
+// Admins who have since been deleted or are no longer admins cannot keep impersonating.
+func (c *AuthClient) getImpersonation(ctx echo.Context, values map[any]any) (*ent.User, *ent.User, error) {
+	adminID, ok := values[authSessionKeyImpersonatorID].(int)
+	if !ok {
+		return nil, nil, NotImpersonatingError{}
+	}
+
+	userID, ok := authenticatedUserID(values)
+	if !ok {
+		return nil, nil, NotImpersonatingError{}
+	}
+
+	users, err := c.orm.User.
+		Query().
+		Where(user.IDIn(adminID, userID)).
+		All(ctx.Request().Context())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var admin, usr *ent.User
+	for _, u := range users {
+		switch u.ID {
+		case adminID:
+			admin = u
+		case userID:
+			usr = u
+		}
+	}
+
+	if admin == nil || usr == nil || !admin.Admin {
+		return nil, nil, ImpersonationNotAllowedError{}
+	}
+
+	return admin, usr, nil
+}
+
+// recordImpersonation records that a given admin started or stopped impersonating a given user.
+func (c *AuthClient) recordImpersonation(
+	ctx echo.Context,
+	action impersonation.Action,
+	admin, usr *ent.User,
+) error {
+	return c.orm.Impersonation.
+		Create().
+		SetAction(action).
+		SetAdminID(admin.ID).
+		SetAdminEmail(admin.Email).
+		SetUserID(usr.ID).
+		SetUserEmail(usr.Email).
+		SetIP(ctx.RealIP()).
+		Exec(ctx.Request().Context())
+}
+
+// sessionUserID returns the ID of the user that owns the session with given values, which is the admin rather than
+// the user they are impersonating, if any, so that the session is listed and revoked along with the admin's.
+func sessionUserID(values map[any]any) (int, bool) {
+	userID, ok := authenticatedUserID(values)
+	if adminID, impersonating := values[authSessionKeyImpersonatorID].(int); ok && impersonating {
+		return adminID, true
+	}
+	return userID, ok
+}
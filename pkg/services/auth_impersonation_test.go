@@ -0,0 +1,83 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/mikestefanello/pagoda/ent/impersonation"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_Impersonate(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+
+	admin, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	admin, err = admin.Update().SetAdmin(true).Save(ctx.Request().Context())
+	require.NoError(t, err)
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Auth.Login(ctx, admin.ID))
+
+	_, err = c.Auth.GetImpersonator(ctx)
+	assert.Equal(t, NotImpersonatingError{}, err)
+
+	// Admins cannot be impersonated
+	err = c.Auth.Impersonate(ctx, admin, admin)
+	assert.Equal(t, ImpersonationNotAllowedError{}, err)
+
+	err = c.Auth.Impersonate(ctx, admin, u)
+	require.NoError(t, err)
+
+	uid, err := c.Auth.GetAuthenticatedUserID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, uid)
+
+	impersonator, err := c.Auth.GetImpersonator(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, admin.ID, impersonator.ID)
+
+	// Which cannot be done again until the admin stops
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	err = c.Auth.Impersonate(ctx, admin, other)
+	assert.Equal(t, ImpersonationNotAllowedError{}, err)
+
+	stopped, err := c.Auth.StopImpersonating(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, admin.ID, stopped.ID)
+
+	uid, err = c.Auth.GetAuthenticatedUserID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, admin.ID, uid)
+
+	_, err = c.Auth.StopImpersonating(ctx)
+	assert.Equal(t, NotImpersonatingError{}, err)
+
+	// Both starting and stopping are recorded
+	logs, err := c.ORM.Impersonation.
+		Query().
+		Where(impersonation.AdminID(admin.ID)).
+		Order(impersonation.ByID()).
+		All(ctx.Request().Context())
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	assert.Equal(t, impersonation.ActionStart, logs[0].Action)
+	assert.Equal(t, impersonation.ActionStop, logs[1].Action)
+	for _, l := range logs {
+		assert.Equal(t, u.ID, l.UserID)
+		assert.Equal(t, u.Email, l.UserEmail)
+		assert.Equal(t, admin.Email, l.AdminEmail)
+	}
+
+	// Admins who are no longer admins cannot keep impersonating
+	require.NoError(t, c.Auth.Impersonate(ctx, admin, u))
+	_, err = admin.Update().SetAdmin(false).Save(ctx.Request().Context())
+	require.NoError(t, err)
+	_, err = c.Auth.GetImpersonator(ctx)
+	assert.Equal(t, ImpersonationNotAllowedError{}, err)
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/invitation"
+)
+
+const (
+	// invitationTokenLength stores the length of generated invitation tokens
+	invitationTokenLength = 48
+)
+
+// InvalidInvitationError is an error returned when an invitation is invalid, has expired or has already been
+// accepted.
+type InvalidInvitationError struct{}
+
+// Error implements the error interface.
+func (e InvalidInvitationError) Error() string {
+	return "invalid invitation"
+}
+
+// InvitationRequiredError is an error returned when a user cannot be registered without an invitation, because
+// registration is invite-only.
+type InvitationRequiredError struct{}
+
+// Error implements the error interface.
+func (e InvitationRequiredError) Error() string {
+	return "registration requires an invitation"
+}
+
+// CreateInvitation creates an invitation for a given email address to register, sent by a given user, which can be
+// accepted until the expiration in configuration. Like API tokens, only a hash of the token is stored, so the token
+// that is returned, which is what goes in the URL, cannot be retrieved again.
+func (c *AuthClient) CreateInvitation(ctx echo.Context, inviterID int, email string) (string, *ent.Invitation, error) {
+	token, err := c.RandomToken(invitationTokenLength)
+	if err != nil {
+		return "", nil, err
+	}
+
+	inv, err := c.orm.Invitation.
+		Create().
+		SetEmail(strings.ToLower(email)).
+		SetToken(hashInvitationToken(token)).
+		SetInviterID(inviterID).
+		SetExpiresAt(time.Now().Add(c.config.App.Registration.InvitationExpiration)).
+		Save(ctx.Request().Context())
+	if err != nil {
+		return "", nil, err
+	}
+
+	return token, inv, nil
+}
+
+// GetInvitations returns the invitations sent by a given user, newest first.
+func (c *AuthClient) GetInvitations(ctx echo.Context, inviterID int) ([]*ent.Invitation, error) {
+	return c.orm.Invitation.
+		Query().
+		Where(invitation.InviterID(inviterID)).
+		Order(ent.Desc(invitation.FieldCreatedAt)).
+		All(ctx.Request().Context())
+}
+
+// RevokeInvitation revokes a given invitation sent by a given user, so it can no longer be accepted. Invitations which
+// have already been accepted cannot be revoked.
+func (c *AuthClient) RevokeInvitation(ctx echo.Context, inviterID, invitationID int) error {
+	deleted, err := c.orm.Invitation.
+		Delete().
+		Where(
+			invitation.ID(invitationID),
+			invitation.InviterID(inviterID),
+			invitation.AcceptedAtIsNil(),
+		).
+		Exec(ctx.Request().Context())
+
+	switch {
+	case err != nil:
+		return err
+	case deleted == 0:
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+}
+
+// GetInvitation returns the invitation matching a given token, if it has not expired or been accepted yet.
+// An InvalidInvitationError is returned otherwise.
+func (c *AuthClient) GetInvitation(ctx echo.Context, token string) (*ent.Invitation, error) {
+	inv, err := c.orm.Invitation.
+		Query().
+		Where(
+			invitation.Token(hashInvitationToken(token)),
+			invitation.ExpiresAtGT(time.Now()),
+			invitation.AcceptedAtIsNil(),
+		).
+		Only(ctx.Request().Context())
+
+	switch {
+	case ent.IsNotFound(err):
+		return nil, InvalidInvitationError{}
+	case err != nil:
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+// AcceptInvitation registers a user with a given name and password for a given invitation, which cannot be accepted
+// again. Since the invitation was sent to the email address of the user, they do not need to verify it.
+// An InvalidInvitationError is returned if the invitation has expired or has already been accepted.
+func (c *AuthClient) AcceptInvitation(ctx echo.Context, inv *ent.Invitation, name, password string) (*ent.User, error) {
+	reqCtx := ctx.Request().Context()
+
+	tx, err := c.orm.Tx(reqCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := func() (*ent.User, error) {
+		// Accepting the invitation first claims it, so concurrent requests cannot both accept it.
+		accepted, err := tx.Invitation.
+			Update().
+			Where(
+				invitation.ID(inv.ID),
+				invitation.ExpiresAtGT(time.Now()),
+				invitation.AcceptedAtIsNil(),
+			).
+			SetAcceptedAt(time.Now()).
+			Save(reqCtx)
+
+		switch {
+		case err != nil:
+			return nil, err
+		case accepted == 0:
+			return nil, InvalidInvitationError{}
+		}
+
+		u, err := tx.User.
+			Create().
+			SetName(name).
+			SetEmail(inv.Email).
+			SetPassword(password).
+			SetVerified(true).
+			Save(reqCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		return u, tx.Invitation.
+			UpdateOneID(inv.ID).
+			SetUserID(u.ID).
+			Exec(reqCtx)
+	}()
+
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	return u, tx.Commit()
+}
+
+// hashInvitationToken hashes an invitation token for storage. Since tokens are long and random, a fast hash is
+// sufficient and allows invitations to be looked up by their token.
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_Invitation(t *testing.T) {
+	inviter, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+
+	token, created, err := c.Auth.CreateInvitation(ctx, inviter.ID, "Invited@localhost.localhost")
+	require.NoError(t, err)
+	assert.Len(t, token, invitationTokenLength)
+	assert.Equal(t, "invited@localhost.localhost", created.Email)
+
+	// Only a hash of the token is stored
+	assert.NotEqual(t, token, created.Token)
+
+	inv, err := c.Auth.GetInvitation(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, inv.ID)
+
+	_, err = c.Auth.GetInvitation(ctx, "invalid")
+	assert.Equal(t, InvalidInvitationError{}, err)
+
+	invitations, err := c.Auth.GetInvitations(ctx, inviter.ID)
+	require.NoError(t, err)
+	require.Len(t, invitations, 1)
+
+	u, err := c.Auth.AcceptInvitation(ctx, inv, "Invited", "password")
+	require.NoError(t, err)
+	assert.Equal(t, inv.Email, u.Email)
+	assert.True(t, u.Verified)
+
+	inv, err = c.ORM.Invitation.Get(ctx.Request().Context(), inv.ID)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, inv.UserID)
+	assert.False(t, inv.AcceptedAt.IsZero())
+
+	// Invitations can only be accepted once
+	_, err = c.Auth.GetInvitation(ctx, token)
+	assert.Equal(t, InvalidInvitationError{}, err)
+	_, err = c.Auth.AcceptInvitation(ctx, inv, "Invited", "password")
+	assert.Equal(t, InvalidInvitationError{}, err)
+
+	// Or revoked
+	err = c.Auth.RevokeInvitation(ctx, inviter.ID, inv.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	// Invitations expire
+	c.Config.App.Registration.InvitationExpiration = -time.Minute
+	defer func() {
+		c.Config.App.Registration.InvitationExpiration = 168 * time.Hour
+	}()
+	token, created, err = c.Auth.CreateInvitation(ctx, inviter.ID, "expired@localhost.localhost")
+	require.NoError(t, err)
+	_, err = c.Auth.GetInvitation(ctx, token)
+	assert.Equal(t, InvalidInvitationError{}, err)
+	_, err = c.Auth.AcceptInvitation(ctx, created, "Expired", "password")
+	assert.Equal(t, InvalidInvitationError{}, err)
+
+	// Pending invitations can only be revoked by the user who sent them
+	err = c.Auth.RevokeInvitation(ctx, u.ID, created.ID)
+	assert.True(t, ent.IsNotFound(err))
+	err = c.Auth.RevokeInvitation(ctx, inviter.ID, created.ID)
+	require.NoError(t, err)
+}
@@ -0,0 +1,258 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/loginattempt"
+)
+
+// unlockAudience stores the audience of unlock tokens, which distinguishes them from other tokens signed with the
+// same key
+const unlockAudience = "unlock"
+
+// AccountLockedError is an error returned when logging in to an account which is temporarily locked
+type AccountLockedError struct {
+	Until time.Time
+}
+
+// Error implements the error interface.
+func (e AccountLockedError) Error() string {
+	return fmt.Sprintf("account locked until %s", e.Until.Format(time.RFC3339))
+}
+
+// LoginThrottledError is an error returned when a login attempt must wait before it can be made
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface.
+func (e LoginThrottledError) Error() string {
+	return fmt.Sprintf("login throttled for %s", e.RetryAfter)
+}
+
+// CheckLoginAllowed checks if a login attempt can be made for a given email address, and user, if one matches it,
+// from the requesting IP address. An AccountLockedError is returned if the user is locked, and a
+// LoginThrottledError is returned if the attempt must wait due to previous failed attempts.
+func (c *AuthClient) CheckLoginAllowed(ctx echo.Context, email string, usr *ent.User) error {
+	now := time.Now()
+	cfg := c.config.App.Lockout
+
+	if usr != nil && usr.LockedUntil.After(now) {
+		return AccountLockedError{Until: usr.LockedUntil}
+	}
+
+	since, err := c.failedLoginsSince(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	// Count the recent failures for the email address and the IP address separately.
+	emailFailures, err := c.orm.LoginAttempt.
+		Query().
+		Where(
+			loginattempt.Email(email),
+			loginattempt.Success(false),
+			loginattempt.CreatedAtGT(since),
+		).
+		Count(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	ipFailures, err := c.orm.LoginAttempt.
+		Query().
+		Where(
+			loginattempt.IP(ctx.RealIP()),
+			loginattempt.Success(false),
+			loginattempt.CreatedAtGT(now.Add(-cfg.Window)),
+		).
+		Count(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	// Determine how much the delay, if any, has doubled.
+	exp := max(emailFailures-cfg.Delay.After, ipFailures-cfg.Delay.IPAfter)
+	if exp < 0 || cfg.Delay.Base <= 0 {
+		return nil
+	}
+
+	delay := cfg.Delay.Max
+	if exp < 32 {
+		delay = min(cfg.Delay.Base<<exp, cfg.Delay.Max)
+	}
+
+	// Find the most recent failure that contributed.
+	last, err := c.orm.LoginAttempt.
+		Query().
+		Where(
+			loginattempt.Success(false),
+			loginattempt.Or(
+				loginattempt.And(loginattempt.Email(email), loginattempt.CreatedAtGT(since)),
+				loginattempt.And(loginattempt.IP(ctx.RealIP()), loginattempt.CreatedAtGT(now.Add(-cfg.Window))),
+			),
+		).
+		Order(ent.Desc(loginattempt.FieldCreatedAt)).
+		First(ctx.Request().Context())
+
+	switch {
+	case ent.IsNotFound(err):
+		return nil
+	case err != nil:
+		return err
+	}
+
+	if wait := last.CreatedAt.Add(delay).Sub(now); wait > 0 {
+		return LoginThrottledError{RetryAfter: wait}
+	}
+
+	return nil
+}
+
+// RecordLoginAttempt records a login attempt for a given email address, and user, if one matches it, from the
+// requesting IP address. If the attempt failed and the user has now failed too many times, the user is locked and
+// the updated user is returned, so they can be notified. Attempts which are too old to count are removed.
+func (c *AuthClient) RecordLoginAttempt(ctx echo.Context, email string, usr *ent.User, success bool) (*ent.User, error) {
+	_, err := c.orm.LoginAttempt.
+		Delete().
+		Where(loginattempt.CreatedAtLT(time.Now().Add(-c.config.App.Lockout.Window))).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	op := c.orm.LoginAttempt.
+		Create().
+		SetEmail(email).
+		SetIP(ctx.RealIP()).
+		SetSuccess(success)
+
+	if usr != nil {
+		op.SetUserID(usr.ID)
+	}
+
+	if _, err := op.Save(ctx.Request().Context()); err != nil {
+		return nil, err
+	}
+
+	if success || usr == nil {
+		return nil, nil
+	}
+
+	since, err := c.failedLoginsSince(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	failures, err := c.orm.LoginAttempt.
+		Query().
+		Where(
+			loginattempt.Email(email),
+			loginattempt.Success(false),
+			loginattempt.CreatedAtGT(since),
+		).
+		Count(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	if failures < c.config.App.Lockout.Attempts {
+		return nil, nil
+	}
+
+	return usr.
+		Update().
+		SetLockedUntil(time.Now().Add(c.config.App.Lockout.Duration)).
+		Save(ctx.Request().Context())
+}
+
+// UnlockUser unlocks a given user and clears their failed login attempts.
+func (c *AuthClient) UnlockUser(ctx echo.Context, userID int) error {
+	u, err := c.orm.User.
+		UpdateOneID(userID).
+		ClearLockedUntil().
+		Save(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	// Record a successful attempt so that the previous failures no longer count.
+	_, err = c.orm.LoginAttempt.
+		Create().
+		SetEmail(u.Email).
+		SetIP(ctx.RealIP()).
+		SetSuccess(true).
+		SetUserID(u.ID).
+		Save(ctx.Request().Context())
+
+	return err
+}
+
+// GenerateUnlockToken generates a token which can be used to unlock a given locked user. The token expires when the
+// lock does.
+func (c *AuthClient) GenerateUnlockToken(usr *ent.User) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   strconv.Itoa(usr.ID),
+		Audience:  jwt.ClaimStrings{unlockAudience},
+		ExpiresAt: jwt.NewNumericDate(usr.LockedUntil),
+	})
+
+	return token.SignedString([]byte(c.config.App.EncryptionKey))
+}
+
+// ValidateUnlockToken validates an unlock token and returns the ID of the user to unlock if the token is valid and
+// has not expired
+func (c *AuthClient) ValidateUnlockToken(token string) (int, error) {
+	var claims jwt.RegisteredClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+
+		return []byte(c.config.App.EncryptionKey), nil
+	},
+		jwt.WithAudience(unlockAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	userID, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return 0, errors.New("invalid or expired token")
+	}
+
+	return userID, nil
+}
+
+// failedLoginsSince returns the time after which failed login attempts for a given email address are counted, which
+// is the start of the lockout window, or the last successful attempt, if more recent.
+func (c *AuthClient) failedLoginsSince(ctx echo.Context, email string) (time.Time, error) {
+	since := time.Now().Add(-c.config.App.Lockout.Window)
+
+	last, err := c.orm.LoginAttempt.
+		Query().
+		Where(
+			loginattempt.Email(email),
+			loginattempt.Success(true),
+			loginattempt.CreatedAtGT(since),
+		).
+		Order(ent.Desc(loginattempt.FieldCreatedAt)).
+		First(ctx.Request().Context())
+
+	switch {
+	case ent.IsNotFound(err):
+		return since, nil
+	case err != nil:
+		return since, err
+	}
+
+	return last.CreatedAt, nil
+}
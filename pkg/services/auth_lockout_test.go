@@ -0,0 +1,103 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent/loginattempt"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_Lockout(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+	ctx.Request().RemoteAddr = "10.1.1.1:1234"
+	cfg := c.Config.App.Lockout
+
+	// Failures below the delay threshold are not throttled
+	for range cfg.Delay.After {
+		require.NoError(t, c.Auth.CheckLoginAllowed(ctx, u.Email, u))
+		locked, err := c.Auth.RecordLoginAttempt(ctx, u.Email, u, false)
+		require.NoError(t, err)
+		assert.Nil(t, locked)
+	}
+
+	// Further attempts must wait
+	err = c.Auth.CheckLoginAllowed(ctx, u.Email, u)
+	require.IsType(t, LoginThrottledError{}, err)
+	assert.Positive(t, err.(LoginThrottledError).RetryAfter)
+	assert.LessOrEqual(t, err.(LoginThrottledError).RetryAfter, cfg.Delay.Base)
+
+	// Reaching the limit locks the user
+	var locked bool
+	for range cfg.Attempts - cfg.Delay.After {
+		l, err := c.Auth.RecordLoginAttempt(ctx, u.Email, u, false)
+		require.NoError(t, err)
+		if l != nil {
+			locked = true
+			u = l
+		}
+	}
+	require.True(t, locked)
+	assert.WithinDuration(t, time.Now().Add(cfg.Duration), u.LockedUntil, time.Minute)
+
+	err = c.Auth.CheckLoginAllowed(ctx, u.Email, u)
+	assert.Equal(t, AccountLockedError{Until: u.LockedUntil}, err)
+
+	// Unlock the user with a token
+	token, err := c.Auth.GenerateUnlockToken(u)
+	require.NoError(t, err)
+	userID, err := c.Auth.ValidateUnlockToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, userID)
+
+	require.NoError(t, c.Auth.UnlockUser(ctx, userID))
+	u, err = c.ORM.User.Get(ctx.Request().Context(), u.ID)
+	require.NoError(t, err)
+	assert.True(t, u.LockedUntil.IsZero())
+
+	// Previous failures no longer count for the user
+	assert.NoError(t, c.Auth.CheckLoginAllowed(ctx, u.Email, u))
+
+	// Email verification tokens cannot unlock users
+	token, err = c.Auth.GenerateEmailVerificationToken(u.Email)
+	require.NoError(t, err)
+	_, err = c.Auth.ValidateUnlockToken(token)
+	assert.Error(t, err)
+
+	// Nor can tokens for other purposes
+	token, err = c.Auth.GenerateMagicLinkToken(ctx, u.ID)
+	require.NoError(t, err)
+	_, err = c.Auth.ValidateUnlockToken(token)
+	assert.Error(t, err)
+}
+
+func TestAuthClient_RecordLoginAttempt_Prune(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	ctx.Request().RemoteAddr = "10.1.1.2:1234"
+
+	old, err := c.ORM.LoginAttempt.
+		Create().
+		SetEmail("prune@localhost.localhost").
+		SetIP("10.1.1.2").
+		SetCreatedAt(time.Now().Add(-c.Config.App.Lockout.Window - time.Minute)).
+		Save(ctx.Request().Context())
+	require.NoError(t, err)
+
+	_, err = c.Auth.RecordLoginAttempt(ctx, "prune@localhost.localhost", nil, false)
+	require.NoError(t, err)
+
+	// Attempts older than the window are removed when new attempts are recorded
+	exists, err := c.ORM.LoginAttempt.Query().Where(loginattempt.ID(old.ID)).Exist(ctx.Request().Context())
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	n, err := c.ORM.LoginAttempt.Query().Where(loginattempt.Email("prune@localhost.localhost")).Count(ctx.Request().Context())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
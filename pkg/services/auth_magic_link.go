@@ -0,0 +1,112 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent/magiclink"
+)
+
+const (
+	// magicLinkAudience stores the audience of magic link tokens, which distinguishes them from other tokens signed
+	// with the same key
+	magicLinkAudience = "magic_link"
+
+	// magicLinkIDLength stores the length of generated magic link token IDs
+	magicLinkIDLength = 32
+)
+
+// InvalidMagicLinkError is an error returned when a magic link token is invalid, has expired or has already been
+// used.
+type InvalidMagicLinkError struct{}
+
+// Error implements the error interface.
+func (e InvalidMagicLinkError) Error() string {
+	return "invalid magic link token"
+}
+
+// GenerateMagicLinkToken generates a token for a magic link which logs a given user in without a password. The token
+// is a JWT which is set to expire based on the duration stored in configuration. Its ID is stored as a MagicLink
+// entity so that it can only be used once.
+func (c *AuthClient) GenerateMagicLinkToken(ctx echo.Context, userID int) (string, error) {
+	// Remove the expired links of the user, which can no longer be used.
+	_, err := c.orm.MagicLink.
+		Delete().
+		Where(
+			magiclink.UserID(userID),
+			magiclink.CreatedAtLT(time.Now().Add(-c.config.App.MagicLinkExpiration)),
+		).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return "", err
+	}
+
+	jti, err := c.RandomToken(magicLinkIDLength)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.orm.MagicLink.
+		Create().
+		SetJti(jti).
+		SetUserID(userID).
+		Save(ctx.Request().Context())
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		ID:        jti,
+		Subject:   strconv.Itoa(userID),
+		Audience:  jwt.ClaimStrings{magicLinkAudience},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(c.config.App.MagicLinkExpiration)),
+	})
+
+	return token.SignedString([]byte(c.config.App.EncryptionKey))
+}
+
+// UseMagicLinkToken validates a magic link token and returns the ID of the user it logs in, if the token is valid,
+// has not expired and has not been used yet. The token cannot be used again. An InvalidMagicLinkError is returned
+// otherwise.
+func (c *AuthClient) UseMagicLinkToken(ctx echo.Context, token string) (int, error) {
+	var claims jwt.RegisteredClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+
+		return []byte(c.config.App.EncryptionKey), nil
+	},
+		jwt.WithAudience(magicLinkAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return 0, InvalidMagicLinkError{}
+	}
+
+	userID, err := strconv.Atoi(claims.Subject)
+	if err != nil || claims.ID == "" {
+		return 0, InvalidMagicLinkError{}
+	}
+
+	// Deleting the link claims it, so concurrent requests cannot both use it.
+	deleted, err := c.orm.MagicLink.
+		Delete().
+		Where(
+			magiclink.Jti(claims.ID),
+			magiclink.UserID(userID),
+		).
+		Exec(ctx.Request().Context())
+
+	switch {
+	case err != nil:
+		return 0, err
+	case deleted == 0:
+		return 0, InvalidMagicLinkError{}
+	}
+
+	return userID, nil
+}
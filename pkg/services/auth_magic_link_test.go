@@ -0,0 +1,53 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_MagicLink(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+
+	token, err := c.Auth.GenerateMagicLinkToken(ctx, u.ID)
+	require.NoError(t, err)
+
+	userID, err := c.Auth.UseMagicLinkToken(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, userID)
+
+	// Links can only be used once
+	_, err = c.Auth.UseMagicLinkToken(ctx, token)
+	assert.Equal(t, InvalidMagicLinkError{}, err)
+
+	// Tampered tokens are rejected
+	token, err = c.Auth.GenerateMagicLinkToken(ctx, u.ID)
+	require.NoError(t, err)
+	_, err = c.Auth.UseMagicLinkToken(ctx, token[:len(token)-2])
+	assert.Equal(t, InvalidMagicLinkError{}, err)
+
+	// As are other tokens signed with the same key
+	verification, err := c.Auth.GenerateEmailVerificationToken(u.Email)
+	require.NoError(t, err)
+	_, err = c.Auth.UseMagicLinkToken(ctx, verification)
+	assert.Equal(t, InvalidMagicLinkError{}, err)
+	_, err = c.Auth.ValidateEmailVerificationToken(token)
+	assert.Error(t, err)
+
+	// Links expire
+	c.Config.App.MagicLinkExpiration = -time.Minute
+	defer func() {
+		c.Config.App.MagicLinkExpiration = 15 * time.Minute
+	}()
+	token, err = c.Auth.GenerateMagicLinkToken(ctx, u.ID)
+	require.NoError(t, err)
+	_, err = c.Auth.UseMagicLinkToken(ctx, token)
+	assert.Equal(t, InvalidMagicLinkError{}, err)
+}
@@ -0,0 +1,550 @@
+package services
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/sessions"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/ent/useridentity"
+	"github.com/mikestefanello/pagoda/pkg/session"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// oauthSessionName stores the name of the session which contains the data of an OAuth login in progress. It is
+	// separate from the authentication session, since the provider sends the user back from another site, and the
+	// cookie of that session is not sent with such requests.
+	oauthSessionName = "oauth"
+
+	// oauthSessionExpiration stores how long users have to complete an OAuth login at the provider
+	oauthSessionExpiration = 10 * time.Minute
+
+	// oauthSessionKeyProvider stores the key used to store the provider of an OAuth login in progress
+	oauthSessionKeyProvider = "provider"
+
+	// oauthSessionKeyState stores the key used to store the state of an OAuth login in progress
+	oauthSessionKeyState = "state"
+
+	// oauthSessionKeyNonce stores the key used to store the ID token nonce of an OAuth login in progress
+	oauthSessionKeyNonce = "nonce"
+
+	// oauthSessionKeyVerifier stores the key used to store the PKCE code verifier of an OAuth login in progress
+	oauthSessionKeyVerifier = "verifier"
+
+	// oauthTokenLength stores the length of generated OAuth state and nonce values
+	oauthTokenLength = 32
+)
+
+// OAuthError is an error returned when an OAuth login cannot be completed, such as when the user denied access or
+// the provider responded with something that cannot be trusted.
+type OAuthError struct {
+	Reason string
+}
+
+// Error implements the error interface.
+func (e OAuthError) Error() string {
+	return "oauth login failed: " + e.Reason
+}
+
+// OAuthAccountExistsError is an error returned when the email address of an external identity belongs to an existing
+// user, but the provider has not verified that address, so the identity cannot be linked to the user.
+type OAuthAccountExistsError struct {
+	Email string
+}
+
+// Error implements the error interface.
+func (e OAuthAccountExistsError) Error() string {
+	return fmt.Sprintf("a user with email %s already exists", e.Email)
+}
+
+// OAuthIdentity is the identity of a user at an external identity provider.
+type OAuthIdentity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// OAuthProvider is an external identity provider that users can log in with.
+type OAuthProvider struct {
+	// Name is the name of the provider used in URLs and stored with identities.
+	Name string
+
+	// Label is the name of the provider shown to users.
+	Label string
+
+	config    config.OAuthProviderConfig
+	mu        sync.Mutex
+	endpoints *oauthEndpoints
+	keys      map[string]*rsa.PublicKey
+}
+
+// oauthEndpoints stores the endpoints of an OAuth provider, which is also the format of the OpenID Connect discovery
+// document.
+type oauthEndpoints struct {
+	Issuer      string `json:"issuer"`
+	AuthURL     string `json:"authorization_endpoint"`
+	TokenURL    string `json:"token_endpoint"`
+	UserInfoURL string `json:"userinfo_endpoint"`
+	JWKSURL     string `json:"jwks_uri"`
+}
+
+// oauthClaims stores the claims about a user contained in an ID token or returned by a user info endpoint.
+type oauthClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+// newOAuthProviders creates the OAuth providers in a given configuration.
+func newOAuthProviders(cfg *config.Config) map[string]*OAuthProvider {
+	providers := make(map[string]*OAuthProvider, len(cfg.OAuth.Providers))
+	for name, pc := range cfg.OAuth.Providers {
+		label := pc.Label
+		if label == "" {
+			label = name
+		}
+
+		providers[name] = &OAuthProvider{
+			Name:   name,
+			Label:  label,
+			config: pc,
+		}
+	}
+	return providers
+}
+
+// GetOAuthProviders returns the OAuth providers that users can log in with, ordered by name.
+func (c *AuthClient) GetOAuthProviders() []*OAuthProvider {
+	providers := make([]*OAuthProvider, 0, len(c.oauth))
+	for _, p := range c.oauth {
+		providers = append(providers, p)
+	}
+
+	sort.Slice(providers, func(i, j int) bool {
+		return providers[i].Name < providers[j].Name
+	})
+
+	return providers
+}
+
+// GetOAuthProvider returns the OAuth provider with a given name, if it exists.
+func (c *AuthClient) GetOAuthProvider(name string) (*OAuthProvider, bool) {
+	p, ok := c.oauth[name]
+	return p, ok
+}
+
+// OAuthAuthCodeURL starts an OAuth login with a given provider and returns the URL of the provider to send the user
+// to. The state, nonce and PKCE code verifier of the login are stored in a short-lived session so that OAuthExchange
+// can check that the response of the provider belongs to this login. The provider sends the user back to a given
+// redirect URL.
+func (c *AuthClient) OAuthAuthCodeURL(ctx echo.Context, p *OAuthProvider, redirectURL string) (string, error) {
+	e, err := p.getEndpoints(ctx.Request().Context())
+	if err != nil {
+		return "", err
+	}
+
+	state, err := c.RandomToken(oauthTokenLength)
+	if err != nil {
+		return "", err
+	}
+
+	nonce, err := c.RandomToken(oauthTokenLength)
+	if err != nil {
+		return "", err
+	}
+
+	verifier := oauth2.GenerateVerifier()
+
+	sess, err := c.oauthSession(ctx)
+	if err != nil {
+		return "", err
+	}
+	sess.Values[oauthSessionKeyProvider] = p.Name
+	sess.Values[oauthSessionKeyState] = state
+	sess.Values[oauthSessionKeyNonce] = nonce
+	sess.Values[oauthSessionKeyVerifier] = verifier
+	if err = sess.Save(ctx.Request(), ctx.Response()); err != nil {
+		return "", err
+	}
+
+	opts := []oauth2.AuthCodeOption{oauth2.S256ChallengeOption(verifier)}
+	if e.Issuer != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("nonce", nonce))
+	}
+
+	return p.oauth2Config(e, redirectURL).AuthCodeURL(state, opts...), nil
+}
+
+// OAuthExchange completes the OAuth login started by OAuthAuthCodeURL once the provider has sent the user back to
+// the redirect URL, and returns the identity of the user at the provider. For OpenID Connect providers, the identity
+// is taken from the ID token, which is verified, and otherwise from the user info endpoint.
+func (c *AuthClient) OAuthExchange(ctx echo.Context, p *OAuthProvider, redirectURL string) (*OAuthIdentity, error) {
+	sess, err := c.oauthSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, _ := sess.Values[oauthSessionKeyProvider].(string)
+	state, _ := sess.Values[oauthSessionKeyState].(string)
+	nonce, _ := sess.Values[oauthSessionKeyNonce].(string)
+	verifier, _ := sess.Values[oauthSessionKeyVerifier].(string)
+
+	// The login can only be completed once.
+	sess.Options.MaxAge = -1
+	if err = sess.Save(ctx.Request(), ctx.Response()); err != nil {
+		return nil, err
+	}
+
+	if provider != p.Name || state == "" ||
+		subtle.ConstantTimeCompare([]byte(ctx.QueryParam("state")), []byte(state)) != 1 {
+		return nil, OAuthError{Reason: "state mismatch"}
+	}
+
+	if reason := ctx.QueryParam("error"); reason != "" {
+		return nil, OAuthError{Reason: reason}
+	}
+
+	reqCtx := ctx.Request().Context()
+	e, err := p.getEndpoints(reqCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := p.oauth2Config(e, redirectURL)
+	token, err := cfg.Exchange(reqCtx, ctx.QueryParam("code"), oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, err
+	}
+
+	var claims oauthClaims
+	if e.Issuer != "" {
+		idToken, ok := token.Extra("id_token").(string)
+		if !ok {
+			return nil, OAuthError{Reason: "missing ID token"}
+		}
+
+		if claims, err = p.verifyIDToken(reqCtx, e, idToken, nonce); err != nil {
+			return nil, err
+		}
+	}
+
+	// Providers may leave the email address out of the ID token, and plain OAuth2 providers do not have one.
+	if claims.Email == "" && e.UserInfoURL != "" {
+		var info oauthClaims
+		if err = getJSON(reqCtx, cfg.Client(reqCtx, token), e.UserInfoURL, &info); err != nil {
+			return nil, err
+		}
+
+		if claims.Subject != "" && claims.Subject != info.Subject {
+			return nil, OAuthError{Reason: "user info subject mismatch"}
+		}
+		claims = info
+	}
+
+	if claims.Subject == "" {
+		return nil, OAuthError{Reason: "missing subject"}
+	}
+
+	return &OAuthIdentity{
+		Provider:      p.Name,
+		Subject:       claims.Subject,
+		Email:         strings.ToLower(claims.Email),
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+	}, nil
+}
+
+// oauthSession returns the session which contains the data of an OAuth login in progress. Its cookie is lax, rather
+// than strict, so that it is sent when the provider sends the user back, and it expires if the login is abandoned.
+func (c *AuthClient) oauthSession(ctx echo.Context) (*sessions.Session, error) {
+	sess, err := session.Get(ctx, oauthSessionName)
+	if err != nil {
+		return nil, err
+	}
+	sess.Options.MaxAge = int(oauthSessionExpiration.Seconds())
+	sess.Options.HttpOnly = true
+	sess.Options.SameSite = http.SameSiteLaxMode
+	return sess, nil
+}
+
+// GetOAuthUser returns the user that a given external identity belongs to. Identities which have not been seen
+// before are linked to the user with the same email address, as long as the provider has verified that address,
+// and a new user is created if there is none, unless registration is invite-only, in which case an
+// InvitationRequiredError is returned.
+func (c *AuthClient) GetOAuthUser(ctx echo.Context, identity *OAuthIdentity) (*ent.User, error) {
+	reqCtx := ctx.Request().Context()
+
+	ident, err := c.orm.UserIdentity.
+		Query().
+		Where(
+			useridentity.Provider(identity.Provider),
+			useridentity.Subject(identity.Subject),
+		).
+		WithUser().
+		Only(reqCtx)
+
+	switch {
+	case err == nil:
+		err = ident.Update().
+			SetEmail(identity.Email).
+			SetLastLoginAt(time.Now()).
+			Exec(reqCtx)
+		return ident.Edges.User, err
+	case !ent.IsNotFound(err):
+		return nil, err
+	}
+
+	if identity.Email == "" {
+		return nil, OAuthError{Reason: "missing email address"}
+	}
+
+	tx, err := c.orm.Tx(reqCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := func() (*ent.User, error) {
+		u, err := tx.User.
+			Query().
+			Where(user.Email(identity.Email)).
+			Only(reqCtx)
+
+		switch {
+		case ent.IsNotFound(err):
+			if c.config.App.Registration.InviteOnly {
+				return nil, InvitationRequiredError{}
+			}
+			u, err = c.createOAuthUser(reqCtx, tx, identity)
+			if err != nil {
+				return nil, err
+			}
+		case err != nil:
+			return nil, err
+		case !identity.EmailVerified:
+			// Otherwise, anyone could take over an account by using its email address with the provider.
+			return nil, OAuthAccountExistsError{Email: identity.Email}
+		}
+
+		err = tx.UserIdentity.
+			Create().
+			SetProvider(identity.Provider).
+			SetSubject(identity.Subject).
+			SetEmail(identity.Email).
+			SetUser(u).
+			Exec(reqCtx)
+		return u, err
+	}()
+
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	return u, tx.Commit()
+}
+
+// createOAuthUser creates a user for a given external identity. Since they log in with the provider, they are given
+// a random password, which they can reset if they want to log in with a password too.
+func (c *AuthClient) createOAuthUser(ctx context.Context, tx *ent.Tx, identity *OAuthIdentity) (*ent.User, error) {
+	password, err := c.RandomToken(64)
+	if err != nil {
+		return nil, err
+	}
+
+	name := identity.Name
+	if name == "" {
+		name, _, _ = strings.Cut(identity.Email, "@")
+	}
+
+	return tx.User.
+		Create().
+		SetName(name).
+		SetEmail(identity.Email).
+		SetPassword(password).
+		SetVerified(identity.EmailVerified).
+		Save(ctx)
+}
+
+// oauth2Config returns the OAuth2 configuration of the provider with given endpoints and redirect URL.
+func (p *OAuthProvider) oauth2Config(e *oauthEndpoints, redirectURL string) *oauth2.Config {
+	scopes := p.config.Scopes
+	if len(scopes) == 0 && e.Issuer != "" {
+		scopes = []string{"openid", "email", "profile"}
+	}
+
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  e.AuthURL,
+			TokenURL: e.TokenURL,
+		},
+		RedirectURL: redirectURL,
+		Scopes:      scopes,
+	}
+}
+
+// getEndpoints returns the endpoints of the provider. For OpenID Connect providers, these are loaded from the
+// discovery document of the issuer the first time they are needed, and endpoints in the configuration take
+// precedence over those discovered.
+func (p *OAuthProvider) getEndpoints(ctx context.Context) (*oauthEndpoints, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.endpoints != nil {
+		return p.endpoints, nil
+	}
+
+	e := &oauthEndpoints{
+		AuthURL:     p.config.AuthURL,
+		TokenURL:    p.config.TokenURL,
+		UserInfoURL: p.config.UserInfoURL,
+	}
+
+	if p.config.Issuer != "" {
+		var d oauthEndpoints
+		url := strings.TrimSuffix(p.config.Issuer, "/") + "/.well-known/openid-configuration"
+		if err := getJSON(ctx, http.DefaultClient, url, &d); err != nil {
+			return nil, err
+		}
+
+		if d.Issuer != p.config.Issuer {
+			return nil, fmt.Errorf("oauth provider %s: issuer mismatch: %s", p.Name, d.Issuer)
+		}
+
+		e.Issuer = d.Issuer
+		e.JWKSURL = d.JWKSURL
+		if e.AuthURL == "" {
+			e.AuthURL = d.AuthURL
+		}
+		if e.TokenURL == "" {
+			e.TokenURL = d.TokenURL
+		}
+		if e.UserInfoURL == "" {
+			e.UserInfoURL = d.UserInfoURL
+		}
+	}
+
+	if e.AuthURL == "" || e.TokenURL == "" {
+		return nil, fmt.Errorf("oauth provider %s: missing endpoints", p.Name)
+	}
+
+	p.endpoints = e
+	return e, nil
+}
+
+// verifyIDToken verifies the signature and the claims of a given ID token, including that it was issued for the
+// login with a given nonce, and returns its claims.
+func (p *OAuthProvider) verifyIDToken(ctx context.Context, e *oauthEndpoints, idToken, nonce string) (oauthClaims, error) {
+	var claims oauthClaims
+	_, err := jwt.ParseWithClaims(idToken, &claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.getKey(ctx, e, kid)
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(e.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return claims, err
+	}
+
+	if nonce == "" || subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return claims, OAuthError{Reason: "nonce mismatch"}
+	}
+
+	return claims, nil
+}
+
+// getKey returns the public key with a given ID that the provider signs ID tokens with. The keys are loaded from the
+// JWKS endpoint of the provider, and are reloaded when the key is not found, since providers rotate their keys.
+func (p *OAuthProvider) getKey(ctx context.Context, e *oauthEndpoints, kid string) (*rsa.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+
+	var set struct {
+		Keys []struct {
+			Kid string `json:"kid"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := getJSON(ctx, http.DefaultClient, e.JWKSURL, &set); err != nil {
+		return nil, err
+	}
+
+	p.keys = make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		exp, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		p.keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(exp).Int64()),
+		}
+	}
+
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+
+	return nil, errors.New("unknown ID token signing key")
+}
+
+// getJSON requests a given URL with a given client and decodes the JSON response into a given value.
+func getJSON(ctx context.Context, client *http.Client, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d from %s", res.StatusCode, url)
+	}
+
+	return json.NewDecoder(res.Body).Decode(v)
+}
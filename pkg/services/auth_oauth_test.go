@@ -0,0 +1,216 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent/useridentity"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOAuthProvider is a local OpenID Connect provider which issues tokens for a configurable user.
+type fakeOAuthProvider struct {
+	*httptest.Server
+	key           *rsa.PrivateKey
+	mu            sync.Mutex
+	codes         map[string]url.Values
+	subject       string
+	email         string
+	emailVerified bool
+}
+
+func newFakeOAuthProvider(t *testing.T) *fakeOAuthProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	f := &fakeOAuthProvider{
+		key:   key,
+		codes: make(map[string]url.Values),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(oauthEndpoints{
+			Issuer:      f.URL,
+			AuthURL:     f.URL + "/authorize",
+			TokenURL:    f.URL + "/token",
+			UserInfoURL: f.URL + "/userinfo",
+			JWKSURL:     f.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kid": "test",
+				"kty": "RSA",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		params, ok := f.codes[r.FormValue("code")]
+		delete(f.codes, r.FormValue("code"))
+		f.mu.Unlock()
+
+		challenge := sha256.Sum256([]byte(r.FormValue("code_verifier")))
+		if !ok || params.Get("code_challenge") != base64.RawURLEncoding.EncodeToString(challenge[:]) {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":            f.URL,
+			"aud":            params.Get("client_id"),
+			"sub":            f.subject,
+			"exp":            time.Now().Add(time.Minute).Unix(),
+			"nonce":          params.Get("nonce"),
+			"email":          f.email,
+			"email_verified": f.emailVerified,
+			"name":           "Fake User",
+		})
+		token.Header["kid"] = "test"
+		idToken, err := token.SignedString(key)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     idToken,
+		})
+	})
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+
+	return f
+}
+
+// authorize approves a given authorization request as if the user logged in to the provider, and returns the query
+// the provider sends the user back with.
+func (f *fakeOAuthProvider) authorize(t *testing.T, authURL string) string {
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	params := u.Query()
+	assert.Equal(t, "S256", params.Get("code_challenge_method"))
+
+	code := rand.Text()
+	f.mu.Lock()
+	f.codes[code] = params
+	f.mu.Unlock()
+
+	return url.Values{
+		"code":  {code},
+		"state": {params.Get("state")},
+	}.Encode()
+}
+
+func TestAuthClient_OAuth(t *testing.T) {
+	f := newFakeOAuthProvider(t)
+	p := &OAuthProvider{
+		Name:  "fake",
+		Label: "Fake",
+		config: config.OAuthProviderConfig{
+			ClientID:     "client",
+			ClientSecret: "secret",
+			Issuer:       f.URL,
+		},
+	}
+	redirectURL := "http://localhost/user/oauth/fake/callback"
+
+	// login performs a login with the provider, sending the user back with a given query, or the one approved by
+	// the provider if it is empty.
+	login := func(query string) (echo.Context, *OAuthIdentity, error) {
+		ctx, _ := tests.NewContext(c.Web, "/")
+		tests.InitSession(ctx)
+
+		authURL, err := c.Auth.OAuthAuthCodeURL(ctx, p, redirectURL)
+		require.NoError(t, err)
+		if query == "" {
+			query = f.authorize(t, authURL)
+		}
+
+		ctx.Request().URL.RawQuery = query
+		identity, err := c.Auth.OAuthExchange(ctx, p, redirectURL)
+		return ctx, identity, err
+	}
+
+	// The state must match the login
+	_, _, err := login("code=abc&state=invalid")
+	assert.Equal(t, OAuthError{Reason: "state mismatch"}, err)
+
+	// New identities create a user
+	f.subject, f.email, f.emailVerified = "new", "OAuth-New@localhost.localhost", true
+	ctx, identity, err := login("")
+	require.NoError(t, err)
+	assert.Equal(t, OAuthIdentity{
+		Provider:      "fake",
+		Subject:       "new",
+		Email:         "oauth-new@localhost.localhost",
+		EmailVerified: true,
+		Name:          "Fake User",
+	}, *identity)
+
+	created, err := c.Auth.GetOAuthUser(ctx, identity)
+	require.NoError(t, err)
+	assert.Equal(t, "Fake User", created.Name)
+	assert.Equal(t, "oauth-new@localhost.localhost", created.Email)
+	assert.True(t, created.Verified)
+
+	// Which is returned for the identity from then on
+	got, err := c.Auth.GetOAuthUser(ctx, identity)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, got.ID)
+
+	// Existing users are linked to identities with their verified email address
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	f.subject, f.email = "existing", u.Email
+	ctx, identity, err = login("")
+	require.NoError(t, err)
+	got, err = c.Auth.GetOAuthUser(ctx, identity)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, got.ID)
+
+	exists, err := c.ORM.UserIdentity.
+		Query().
+		Where(
+			useridentity.Provider("fake"),
+			useridentity.Subject("existing"),
+			useridentity.UserID(u.ID),
+		).
+		Exist(ctx.Request().Context())
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	// But not to identities with an unverified email address
+	u, err = tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	f.subject, f.email, f.emailVerified = "unverified", u.Email, false
+	ctx, identity, err = login("")
+	require.NoError(t, err)
+	_, err = c.Auth.GetOAuthUser(ctx, identity)
+	assert.Equal(t, OAuthAccountExistsError{Email: u.Email}, err)
+}
@@ -0,0 +1,361 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/webauthncredential"
+	"github.com/mikestefanello/pagoda/pkg/session"
+)
+
+const (
+	// authSessionKeyPasskey stores the key used to store the state of a passkey registration or login in progress
+	authSessionKeyPasskey = "passkey"
+)
+
+// PasskeyError is an error returned when a passkey cannot be registered or used to log in, such as when the response
+// of the authenticator is invalid or does not belong to the registration or login in progress.
+type PasskeyError struct {
+	Reason string
+}
+
+// Error implements the error interface.
+func (e PasskeyError) Error() string {
+	return "passkey failed: " + e.Reason
+}
+
+// passkeyUser is a user that passkeys are registered to and used by, along with the passkeys they already have.
+type passkeyUser struct {
+	*ent.User
+	passkeys []*ent.WebAuthnCredential
+}
+
+// WebAuthnID implements webauthn.User.
+func (u passkeyUser) WebAuthnID() []byte {
+	return passkeyUserHandle(u.ID)
+}
+
+// WebAuthnName implements webauthn.User.
+func (u passkeyUser) WebAuthnName() string {
+	return u.Email
+}
+
+// WebAuthnDisplayName implements webauthn.User.
+func (u passkeyUser) WebAuthnDisplayName() string {
+	return u.Name
+}
+
+// WebAuthnCredentials implements webauthn.User.
+func (u passkeyUser) WebAuthnCredentials() []webauthn.Credential {
+	creds := make([]webauthn.Credential, 0, len(u.passkeys))
+	for _, p := range u.passkeys {
+		id, err := base64.RawURLEncoding.DecodeString(p.CredentialID)
+		if err != nil {
+			continue
+		}
+
+		transports := make([]protocol.AuthenticatorTransport, len(p.Transports))
+		for i, t := range p.Transports {
+			transports[i] = protocol.AuthenticatorTransport(t)
+		}
+
+		creds = append(creds, webauthn.Credential{
+			ID:              id,
+			PublicKey:       p.PublicKey,
+			AttestationType: p.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: p.BackupEligible,
+				BackupState:    p.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				SignCount: p.SignCount,
+			},
+		})
+	}
+	return creds
+}
+
+// newWebAuthn creates the relying party that passkeys are registered with, using the configuration. Since passkeys
+// replace both the password and the second factor, they must be discoverable and verify the user.
+func newWebAuthn(cfg *config.Config) (*webauthn.WebAuthn, error) {
+	host, err := url.Parse(cfg.App.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	rpID := cfg.App.Passkeys.RPID
+	if rpID == "" {
+		rpID = host.Hostname()
+	}
+
+	origins := cfg.App.Passkeys.Origins
+	if len(origins) == 0 {
+		origins = []string{host.Scheme + "://" + host.Host}
+	}
+
+	timeout := webauthn.TimeoutConfig{
+		Enforce:    true,
+		Timeout:    cfg.App.Passkeys.Timeout,
+		TimeoutUVD: cfg.App.Passkeys.Timeout,
+	}
+
+	return webauthn.New(&webauthn.Config{
+		RPID:          rpID,
+		RPDisplayName: cfg.App.Name,
+		RPOrigins:     origins,
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			RequireResidentKey: protocol.ResidentKeyRequired(),
+			ResidentKey:        protocol.ResidentKeyRequirementRequired,
+			UserVerification:   protocol.VerificationRequired,
+		},
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        timeout,
+			Registration: timeout,
+		},
+	})
+}
+
+// GetPasskeys returns the passkeys registered to a given user, newest first.
+func (c *AuthClient) GetPasskeys(ctx echo.Context, userID int) ([]*ent.WebAuthnCredential, error) {
+	return c.orm.WebAuthnCredential.
+		Query().
+		Where(webauthncredential.UserID(userID)).
+		Order(ent.Desc(webauthncredential.FieldCreatedAt)).
+		All(ctx.Request().Context())
+}
+
+// DeletePasskey deletes a given passkey registered to a given user, so it can no longer be used to log in.
+func (c *AuthClient) DeletePasskey(ctx echo.Context, userID, passkeyID int) error {
+	deleted, err := c.orm.WebAuthnCredential.
+		Delete().
+		Where(
+			webauthncredential.ID(passkeyID),
+			webauthncredential.UserID(userID),
+		).
+		Exec(ctx.Request().Context())
+
+	switch {
+	case err != nil:
+		return err
+	case deleted == 0:
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+}
+
+// BeginPasskeyRegistration starts registering a passkey for a given user and returns the options to pass to the
+// authenticator. The challenge is stored in the session so that FinishPasskeyRegistration can check that the response
+// of the authenticator belongs to this registration.
+func (c *AuthClient) BeginPasskeyRegistration(ctx echo.Context, u *ent.User) (*protocol.CredentialCreation, error) {
+	passkeys, err := c.GetPasskeys(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	pu := passkeyUser{User: u, passkeys: passkeys}
+
+	// Authenticators which already have a passkey for the user are excluded, so they do not register it again.
+	creation, sd, err := c.webauthn.BeginRegistration(pu,
+		webauthn.WithExclusions(webauthn.Credentials(pu.WebAuthnCredentials()).CredentialDescriptors()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.storePasskeySession(ctx, sd); err != nil {
+		return nil, err
+	}
+
+	return creation, nil
+}
+
+// FinishPasskeyRegistration completes the registration started by BeginPasskeyRegistration with a given response of
+// the authenticator, and stores the passkey for the user under a given name.
+// A PasskeyError is returned if the response is invalid.
+func (c *AuthClient) FinishPasskeyRegistration(ctx echo.Context, u *ent.User, name, response string) (*ent.WebAuthnCredential, error) {
+	sd, err := c.loadPasskeySession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes([]byte(response))
+	if err != nil {
+		return nil, passkeyError(err)
+	}
+
+	cred, err := c.webauthn.CreateCredential(passkeyUser{User: u}, *sd, parsed)
+	if err != nil {
+		return nil, passkeyError(err)
+	}
+
+	transports := make([]string, len(cred.Transport))
+	for i, t := range cred.Transport {
+		transports[i] = string(t)
+	}
+
+	p, err := c.orm.WebAuthnCredential.
+		Create().
+		SetName(name).
+		SetCredentialID(base64.RawURLEncoding.EncodeToString(cred.ID)).
+		SetPublicKey(cred.PublicKey).
+		SetAttestationType(cred.AttestationType).
+		SetTransports(transports).
+		SetSignCount(cred.Authenticator.SignCount).
+		SetBackupEligible(cred.Flags.BackupEligible).
+		SetBackupState(cred.Flags.BackupState).
+		SetUserID(u.ID).
+		Save(ctx.Request().Context())
+
+	if ent.IsConstraintError(err) {
+		return nil, PasskeyError{Reason: "passkey already registered"}
+	}
+
+	return p, err
+}
+
+// BeginPasskeyLogin starts logging in with a passkey and returns the options to pass to the authenticator. Since
+// passkeys are discoverable, the user does not have to be known yet, as the authenticator tells which user the passkey
+// belongs to. The challenge is stored in the session so that FinishPasskeyLogin can check that the response of the
+// authenticator belongs to this login.
+func (c *AuthClient) BeginPasskeyLogin(ctx echo.Context) (*protocol.CredentialAssertion, error) {
+	assertion, sd, err := c.webauthn.BeginDiscoverableLogin()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.storePasskeySession(ctx, sd); err != nil {
+		return nil, err
+	}
+
+	return assertion, nil
+}
+
+// FinishPasskeyLogin completes the login started by BeginPasskeyLogin with a given response of the authenticator, and
+// returns the user the passkey belongs to, who can then be logged in.
+// A PasskeyError is returned if the response is invalid or the passkey is not registered.
+func (c *AuthClient) FinishPasskeyLogin(ctx echo.Context, response string) (*ent.User, error) {
+	reqCtx := ctx.Request().Context()
+
+	sd, err := c.loadPasskeySession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes([]byte(response))
+	if err != nil {
+		return nil, passkeyError(err)
+	}
+
+	loadUser := func(rawID, userHandle []byte) (webauthn.User, error) {
+		if len(userHandle) != 8 {
+			return nil, errors.New("invalid user handle")
+		}
+
+		u, err := c.orm.User.Get(reqCtx, int(binary.BigEndian.Uint64(userHandle)))
+		if err != nil {
+			return nil, err
+		}
+
+		passkeys, err := u.QueryWebauthnCredentials().All(reqCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		return passkeyUser{User: u, passkeys: passkeys}, nil
+	}
+
+	wu, cred, err := c.webauthn.ValidatePasskeyLogin(loadUser, *sd, parsed)
+	if err != nil {
+		return nil, passkeyError(err)
+	}
+
+	// A signature counter which did not increase means the passkey may have been copied from the authenticator.
+	if cred.Authenticator.CloneWarning {
+		return nil, PasskeyError{Reason: "signature counter did not increase"}
+	}
+
+	err = c.orm.WebAuthnCredential.
+		Update().
+		Where(webauthncredential.CredentialID(base64.RawURLEncoding.EncodeToString(cred.ID))).
+		SetSignCount(cred.Authenticator.SignCount).
+		SetBackupState(cred.Flags.BackupState).
+		SetLastUsedAt(time.Now()).
+		Exec(reqCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return wu.(passkeyUser).User, nil
+}
+
+// storePasskeySession stores the state of a given passkey registration or login in the session.
+func (c *AuthClient) storePasskeySession(ctx echo.Context, sd *webauthn.SessionData) error {
+	b, err := json.Marshal(sd)
+	if err != nil {
+		return err
+	}
+
+	sess, err := session.Get(ctx, authSessionName)
+	if err != nil {
+		return err
+	}
+	sess.Values[authSessionKeyPasskey] = string(b)
+	return sess.Save(ctx.Request(), ctx.Response())
+}
+
+// loadPasskeySession loads the state of the passkey registration or login in progress from the session, which is
+// removed so that it can only be completed once.
+func (c *AuthClient) loadPasskeySession(ctx echo.Context) (*webauthn.SessionData, error) {
+	sess, err := session.Get(ctx, authSessionName)
+	if err != nil {
+		return nil, err
+	}
+
+	v, _ := sess.Values[authSessionKeyPasskey].(string)
+	delete(sess.Values, authSessionKeyPasskey)
+	if err = sess.Save(ctx.Request(), ctx.Response()); err != nil {
+		return nil, err
+	}
+
+	if v == "" {
+		return nil, PasskeyError{Reason: "no passkey registration or login in progress"}
+	}
+
+	var sd webauthn.SessionData
+	if err = json.Unmarshal([]byte(v), &sd); err != nil {
+		return nil, err
+	}
+
+	return &sd, nil
+}
+
+// passkeyUserHandle returns the user handle of a given user ID, which authenticators store with passkeys in order to
+// tell which user a passkey belongs to.
+func passkeyUserHandle(userID int) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(userID))
+	return b
+}
+
+// passkeyError converts an error returned by the WebAuthn library when verifying a response into a PasskeyError.
+func passkeyError(err error) error {
+	var pe *protocol.Error
+	if errors.As(err, &pe) && pe.Details != "" {
+		if pe.DevInfo != "" {
+			return PasskeyError{Reason: pe.Details + ": " + pe.DevInfo}
+		}
+		return PasskeyError{Reason: pe.Details}
+	}
+	return PasskeyError{Reason: err.Error()}
+}
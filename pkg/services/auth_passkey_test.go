@@ -0,0 +1,88 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_Passkey(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	a := tests.NewAuthenticator(c.Config.App.Host)
+
+	register := func(a *tests.Authenticator) (string, error) {
+		creation, err := c.Auth.BeginPasskeyRegistration(ctx, u)
+		require.NoError(t, err)
+		options, err := json.Marshal(creation)
+		require.NoError(t, err)
+		return a.Create(options)
+	}
+
+	login := func() (string, error) {
+		assertion, err := c.Auth.BeginPasskeyLogin(ctx)
+		require.NoError(t, err)
+		options, err := json.Marshal(assertion)
+		require.NoError(t, err)
+		return a.Get(options)
+	}
+
+	response, err := register(a)
+	require.NoError(t, err)
+	p, err := c.Auth.FinishPasskeyRegistration(ctx, u, "Laptop", response)
+	require.NoError(t, err)
+	assert.Equal(t, "Laptop", p.Name)
+	assert.Equal(t, u.ID, p.UserID)
+
+	// Registrations can only be completed once
+	_, err = c.Auth.FinishPasskeyRegistration(ctx, u, "Laptop", response)
+	assert.IsType(t, PasskeyError{}, err)
+
+	// And only for the user they were started for
+	response, err = register(tests.NewAuthenticator(c.Config.App.Host))
+	require.NoError(t, err)
+	_, err = c.Auth.FinishPasskeyRegistration(ctx, usr, "Laptop", response)
+	assert.IsType(t, PasskeyError{}, err)
+
+	passkeys, err := c.Auth.GetPasskeys(ctx, u.ID)
+	require.NoError(t, err)
+	require.Len(t, passkeys, 1)
+
+	response, err = login()
+	require.NoError(t, err)
+	loggedIn, err := c.Auth.FinishPasskeyLogin(ctx, response)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, loggedIn.ID)
+
+	p, err = c.ORM.WebAuthnCredential.Get(ctx.Request().Context(), p.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, p.SignCount)
+	assert.False(t, p.LastUsedAt.IsZero())
+
+	// Responses cannot be replayed
+	_, err = c.Auth.BeginPasskeyLogin(ctx)
+	require.NoError(t, err)
+	_, err = c.Auth.FinishPasskeyLogin(ctx, response)
+	assert.IsType(t, PasskeyError{}, err)
+
+	// Passkeys can only be deleted by the user they are registered to
+	err = c.Auth.DeletePasskey(ctx, usr.ID, p.ID)
+	assert.True(t, ent.IsNotFound(err))
+	err = c.Auth.DeletePasskey(ctx, u.ID, p.ID)
+	require.NoError(t, err)
+
+	// And can no longer be used to log in once deleted
+	response, err = login()
+	require.NoError(t, err)
+	_, err = c.Auth.FinishPasskeyLogin(ctx, response)
+	assert.IsType(t, PasskeyError{}, err)
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent/permission"
+	"github.com/mikestefanello/pagoda/ent/role"
+	"github.com/mikestefanello/pagoda/ent/user"
+)
+
+// GetPermissions returns the names of the permissions granted to a given user by their roles.
+// Admins are not granted any permissions here, since they implicitly have all of them.
+func (c *AuthClient) GetPermissions(ctx echo.Context, userID int) (map[string]bool, error) {
+	names, err := c.orm.Permission.
+		Query().
+		Where(permission.HasRolesWith(role.HasUsersWith(user.ID(userID)))).
+		Select(permission.FieldName).
+		Strings(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := make(map[string]bool, len(names))
+	for _, name := range names {
+		permissions[name] = true
+	}
+	return permissions, nil
+}
+
+// RegisterPermissions creates the permissions with given names which do not exist yet, so they can be granted to
+// roles.
+func (c *AuthClient) RegisterPermissions(ctx context.Context, names ...string) error {
+	existing, err := c.orm.Permission.
+		Query().
+		Where(permission.NameIn(names...)).
+		Select(permission.FieldName).
+		Strings(ctx)
+	if err != nil {
+		return err
+	}
+
+	exists := make(map[string]bool, len(existing))
+	for _, name := range existing {
+		exists[name] = true
+	}
+
+	for _, name := range names {
+		if exists[name] {
+			continue
+		}
+
+		err = c.orm.Permission.
+			Create().
+			SetName(name).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/mikestefanello/pagoda/ent/permission"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_Permissions(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+	reqCtx := ctx.Request().Context()
+
+	// Registering permissions more than once does not duplicate them
+	names := []string{"test.read", "test.write"}
+	require.NoError(t, c.Auth.RegisterPermissions(reqCtx, names...))
+	require.NoError(t, c.Auth.RegisterPermissions(reqCtx, names...))
+
+	perms, err := c.ORM.Permission.
+		Query().
+		Where(permission.NameIn(names...)).
+		All(reqCtx)
+	require.NoError(t, err)
+	require.Len(t, perms, 2)
+
+	got, err := c.Auth.GetPermissions(ctx, u.ID)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	// Permissions are granted by roles
+	err = c.ORM.Role.
+		Create().
+		SetName("test").
+		AddPermissions(perms[0]).
+		AddUsers(u).
+		Exec(reqCtx)
+	require.NoError(t, err)
+
+	got, err = c.Auth.GetPermissions(ctx, u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{perms[0].Name: true}, got)
+}
@@ -0,0 +1,192 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/remembertoken"
+)
+
+const (
+	// rememberCookieName stores the name of the cookie which contains the remember me token
+	rememberCookieName = "remember"
+
+	// rememberTokenLength stores the length of generated remember me series and tokens
+	rememberTokenLength = 64
+)
+
+// RememberTokenReusedError is an error returned when a remember me token which has already been replaced is used.
+// Since the browser it was issued to always receives the replacement, this means the token was stolen.
+type RememberTokenReusedError struct {
+	UserID int
+}
+
+// Error implements the error interface.
+func (e RememberTokenReusedError) Error() string {
+	return fmt.Sprintf("remember me token reused for user %d", e.UserID)
+}
+
+// Remember issues a remember me token for a given user, who has just logged in, so they are logged back in once
+// their session expires. The token belongs to a series which identifies the browser, and a new token is issued in
+// the same series every time it is used. Like password tokens, only a hash of the token is stored.
+func (c *AuthClient) Remember(ctx echo.Context, userID int) error {
+	series, err := c.RandomToken(rememberTokenLength)
+	if err != nil {
+		return err
+	}
+
+	token, err := c.RandomToken(rememberTokenLength)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.orm.RememberToken.
+		Create().
+		SetSeries(series).
+		SetToken(token).
+		SetSessionToken(c.sessionToken(ctx)).
+		SetUserID(userID).
+		Save(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	c.setRememberCookie(ctx, series, token)
+	return nil
+}
+
+// LoginRemembered logs in the user that the remember me token of the request belongs to, if it is valid, and
+// returns them. The token is replaced with a new one. If the token has already been replaced, it was stolen, so all
+// sessions and remember me tokens of the user are revoked and a RememberTokenReusedError is returned. If the user is
+// not allowed to log in, such as when they are locked, the token is deleted and the error of the check is returned.
+func (c *AuthClient) LoginRemembered(ctx echo.Context) (*ent.User, error) {
+	cookie, err := ctx.Cookie(rememberCookieName)
+	if err != nil {
+		return nil, NotAuthenticatedError{}
+	}
+
+	series, token, ok := strings.Cut(cookie.Value, ":")
+	if !ok {
+		c.clearRememberCookie(ctx)
+		return nil, NotAuthenticatedError{}
+	}
+
+	rt, err := c.orm.RememberToken.
+		Query().
+		Where(
+			remembertoken.Series(series),
+			remembertoken.CreatedAtGT(time.Now().Add(-c.config.App.RememberMe.Expiration)),
+		).
+		WithUser().
+		Only(ctx.Request().Context())
+
+	switch {
+	case ent.IsNotFound(err):
+		c.clearRememberCookie(ctx)
+		return nil, NotAuthenticatedError{}
+	case err != nil:
+		return nil, err
+	}
+
+	var rotate bool
+	switch {
+	case c.CheckPassword(token, rt.Token) == nil:
+		rotate = true
+	case rt.PreviousToken != "" &&
+		time.Since(rt.RotatedAt) < c.config.App.RememberMe.GracePeriod &&
+		c.CheckPassword(token, rt.PreviousToken) == nil:
+		// A concurrent request from the same browser already replaced the token, and the replacement is on its way.
+	default:
+		if err = c.RevokeSessions(ctx, rt.UserID); err != nil {
+			return nil, err
+		}
+		c.clearRememberCookie(ctx)
+		return nil, RememberTokenReusedError{UserID: rt.UserID}
+	}
+
+	// Users who could not log in now are not logged back in either, and must log in again once they can.
+	err = c.CheckLoginAllowed(ctx, rt.Edges.User.Email, rt.Edges.User)
+	if err == nil {
+		err = c.CheckVerified(rt.Edges.User)
+	}
+	switch err.(type) {
+	case nil:
+	case AccountLockedError, LoginThrottledError, UnverifiedError:
+		if err := c.Forget(ctx); err != nil {
+			return nil, err
+		}
+		return nil, err
+	default:
+		return nil, err
+	}
+
+	if err = c.Login(ctx, rt.UserID); err != nil {
+		return nil, err
+	}
+
+	if rotate {
+		token, err = c.RandomToken(rememberTokenLength)
+		if err != nil {
+			return nil, err
+		}
+
+		err = rt.Update().
+			SetToken(token).
+			SetPreviousToken(rt.Token).
+			SetSessionToken(c.sessionToken(ctx)).
+			SetRotatedAt(time.Now()).
+			Exec(ctx.Request().Context())
+		if err != nil {
+			return nil, err
+		}
+
+		c.setRememberCookie(ctx, series, token)
+	}
+
+	return rt.Edges.User, nil
+}
+
+// Forget deletes the remember me token of the request, if any, so the user is not logged back in.
+func (c *AuthClient) Forget(ctx echo.Context) error {
+	cookie, err := ctx.Cookie(rememberCookieName)
+	if err != nil {
+		return nil
+	}
+
+	c.clearRememberCookie(ctx)
+
+	series, _, _ := strings.Cut(cookie.Value, ":")
+	_, err = c.orm.RememberToken.
+		Delete().
+		Where(remembertoken.Series(series)).
+		Exec(ctx.Request().Context())
+
+	return err
+}
+
+// setRememberCookie adds the remember me cookie containing a given series and token to the response.
+func (c *AuthClient) setRememberCookie(ctx echo.Context, series, token string) {
+	ctx.SetCookie(&http.Cookie{
+		Name:     rememberCookieName,
+		Value:    series + ":" + token,
+		Path:     "/",
+		MaxAge:   int(c.config.App.RememberMe.Expiration.Seconds()),
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+// clearRememberCookie removes the remember me cookie.
+func (c *AuthClient) clearRememberCookie(ctx echo.Context) {
+	ctx.SetCookie(&http.Cookie{
+		Name:     rememberCookieName,
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
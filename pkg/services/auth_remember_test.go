@@ -0,0 +1,112 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent/remembertoken"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_Remember(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	// newContext creates a request context which sends a given remember me cookie.
+	newContext := func(cookie *http.Cookie) (echo.Context, *httptest.ResponseRecorder) {
+		ctx, rec := tests.NewContext(c.Web, "/")
+		tests.InitSession(ctx)
+		if cookie != nil {
+			ctx.Request().AddCookie(cookie)
+		}
+		return ctx, rec
+	}
+
+	// rememberCookie returns the remember me cookie set in a given response.
+	rememberCookie := func(rec *httptest.ResponseRecorder) *http.Cookie {
+		for _, cookie := range rec.Result().Cookies() {
+			if cookie.Name == rememberCookieName {
+				return cookie
+			}
+		}
+		return nil
+	}
+
+	// Requests without a cookie are not logged in
+	ctx, _ := newContext(nil)
+	_, err = c.Auth.LoginRemembered(ctx)
+	assert.Equal(t, NotAuthenticatedError{}, err)
+
+	ctx, rec := newContext(nil)
+	require.NoError(t, c.Auth.Remember(ctx, u.ID))
+	first := rememberCookie(rec)
+	require.NotNil(t, first)
+
+	// The token is logged in with and replaced
+	ctx, rec = newContext(first)
+	got, err := c.Auth.LoginRemembered(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, got.ID)
+	userID, err := c.Auth.GetAuthenticatedUserID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, userID)
+	second := rememberCookie(rec)
+	require.NotNil(t, second)
+	assert.NotEqual(t, first.Value, second.Value)
+
+	// The previous token is still accepted briefly, for concurrent requests
+	ctx, rec = newContext(first)
+	_, err = c.Auth.LoginRemembered(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, rememberCookie(rec))
+
+	// Once that has passed, reusing the previous token means it was stolen
+	_, err = c.ORM.RememberToken.
+		Update().
+		Where(remembertoken.UserID(u.ID)).
+		SetRotatedAt(time.Now().Add(-c.Config.App.RememberMe.GracePeriod)).
+		Save(ctx.Request().Context())
+	require.NoError(t, err)
+
+	ctx, _ = newContext(first)
+	_, err = c.Auth.LoginRemembered(ctx)
+	assert.Equal(t, RememberTokenReusedError{UserID: u.ID}, err)
+
+	// Which revokes the token for everyone
+	ctx, _ = newContext(second)
+	_, err = c.Auth.LoginRemembered(ctx)
+	assert.Equal(t, NotAuthenticatedError{}, err)
+
+	// Forgetting deletes the token
+	ctx, rec = newContext(nil)
+	require.NoError(t, c.Auth.Remember(ctx, u.ID))
+	cookie := rememberCookie(rec)
+	ctx, _ = newContext(cookie)
+	require.NoError(t, c.Auth.Forget(ctx))
+	ctx, _ = newContext(cookie)
+	_, err = c.Auth.LoginRemembered(ctx)
+	assert.Equal(t, NotAuthenticatedError{}, err)
+
+	// Locked users are not logged back in, and their token is deleted
+	ctx, rec = newContext(nil)
+	require.NoError(t, c.Auth.Remember(ctx, u.ID))
+	cookie = rememberCookie(rec)
+	u, err = u.Update().SetLockedUntil(time.Now().Add(time.Hour)).Save(ctx.Request().Context())
+	require.NoError(t, err)
+	ctx, _ = newContext(cookie)
+	_, err = c.Auth.LoginRemembered(ctx)
+	assert.IsType(t, AccountLockedError{}, err)
+	_, err = c.Auth.GetAuthenticatedUserID(ctx)
+	assert.Equal(t, NotAuthenticatedError{}, err)
+
+	require.NoError(t, c.Auth.UnlockUser(ctx, u.ID))
+	ctx, _ = newContext(cookie)
+	_, err = c.Auth.LoginRemembered(ctx)
+	assert.Equal(t, NotAuthenticatedError{}, err)
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/remembertoken"
+	entsession "github.com/mikestefanello/pagoda/ent/session"
+	"github.com/mikestefanello/pagoda/pkg/session"
+)
+
+// GetSessions returns the active sessions of a given user, most recently used first.
+func (c *AuthClient) GetSessions(ctx echo.Context, userID int) ([]*ent.Session, error) {
+	return c.orm.Session.
+		Query().
+		Where(
+			entsession.UserID(userID),
+			entsession.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(entsession.FieldLastSeenAt)).
+		All(ctx.Request().Context())
+}
+
+// IsCurrentSession determines if a given session is the one making the request.
+func (c *AuthClient) IsCurrentSession(ctx echo.Context, s *ent.Session) bool {
+	token := c.sessionToken(ctx)
+	return token != "" && token == s.Token
+}
+
+// RevokeSession revokes a given session of a given user, which immediately logs the session out, along with the
+// remember me token that it was logged in with, if any, so it is not logged back in.
+func (c *AuthClient) RevokeSession(ctx echo.Context, userID, sessionID int) error {
+	s, err := c.orm.Session.
+		Query().
+		Where(
+			entsession.ID(sessionID),
+			entsession.UserID(userID),
+		).
+		Only(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	_, err = c.orm.RememberToken.
+		Delete().
+		Where(remembertoken.SessionToken(s.Token)).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return c.orm.Session.
+		DeleteOne(s).
+		Exec(ctx.Request().Context())
+}
+
+// RevokeSessions revokes all sessions and remember me tokens of a given user, such as when their password is reset.
+func (c *AuthClient) RevokeSessions(ctx echo.Context, userID int) error {
+	_, err := c.orm.RememberToken.
+		Delete().
+		Where(remembertoken.UserID(userID)).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	_, err = c.orm.Session.
+		Delete().
+		Where(entsession.UserID(userID)).
+		Exec(ctx.Request().Context())
+	return err
+}
+
+// RevokeOtherSessions revokes all sessions and remember me tokens of a given user, except for the session making the
+// request, such as when they change their password.
+func (c *AuthClient) RevokeOtherSessions(ctx echo.Context, userID int) error {
+	token := c.sessionToken(ctx)
+
+	_, err := c.orm.RememberToken.
+		Delete().
+		Where(
+			remembertoken.UserID(userID),
+			remembertoken.Or(
+				remembertoken.SessionTokenNEQ(token),
+				remembertoken.SessionTokenIsNil(),
+			),
+		).
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	_, err = c.orm.Session.
+		Delete().
+		Where(
+			entsession.UserID(userID),
+			entsession.TokenNEQ(token),
+		).
+		Exec(ctx.Request().Context())
+	return err
+}
+
+// sessionToken returns the hash of the ID of the authentication session of the request, which is how the session
+// is stored, or an empty string if it has not been saved.
+func (c *AuthClient) sessionToken(ctx echo.Context) string {
+	sess, err := session.Get(ctx, authSessionName)
+	if err != nil || sess.ID == "" {
+		return ""
+	}
+	return hashSessionID(sess.ID)
+}
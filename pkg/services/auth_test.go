@@ -8,6 +8,7 @@ import (
 
 	"github.com/mikestefanello/pagoda/ent/passwordtoken"
 	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/pkg/tests"
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/stretchr/testify/require"
@@ -105,6 +106,56 @@ func TestAuthClient_DeletePasswordTokens(t *testing.T) {
 	assert.Equal(t, 0, count)
 }
 
+func TestAuthClient_DeleteUser(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	_, _, err = c.Auth.GeneratePasswordResetToken(ctx, u.ID)
+	require.NoError(t, err)
+	_, err = c.Auth.GenerateMagicLinkToken(ctx, u.ID)
+	require.NoError(t, err)
+
+	err = c.Auth.DeleteUser(ctx, u.ID)
+	require.NoError(t, err)
+
+	exists, err := c.ORM.User.
+		Query().
+		Where(user.ID(u.ID)).
+		Exist(context.Background())
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	count, err := c.ORM.PasswordToken.
+		Query().
+		Where(passwordtoken.UserID(u.ID)).
+		Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestAuthClient_CheckVerified(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	u.Verified = false
+	u.CreatedAt = time.Now().Add(-time.Hour)
+
+	// Verification is not required by default
+	assert.NoError(t, c.Auth.CheckVerified(u))
+
+	// Or within the grace period
+	c.Config.App.UnverifiedLoginGracePeriod = 2 * time.Hour
+	defer func() {
+		c.Config.App.UnverifiedLoginGracePeriod = 0
+	}()
+	assert.NoError(t, c.Auth.CheckVerified(u))
+
+	// But it is after the grace period
+	c.Config.App.UnverifiedLoginGracePeriod = 30 * time.Minute
+	assert.Equal(t, UnverifiedError{}, c.Auth.CheckVerified(u))
+
+	u.Verified = true
+	assert.NoError(t, c.Auth.CheckVerified(u))
+}
+
 func TestAuthClient_RandomToken(t *testing.T) {
 	length := c.Config.App.PasswordToken.Length
 	a, err := c.Auth.RandomToken(length)
@@ -0,0 +1,311 @@
+package services
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/recoverycode"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/pkg/session"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+const (
+	// authSessionKeyPendingUserID stores the key used to store the ID of the user who has entered their password
+	// but not yet their second factor in the session
+	authSessionKeyPendingUserID = "pending_user_id"
+
+	// authSessionKeyPendingAt stores the key used to store when the user entered their password in the session
+	authSessionKeyPendingAt = "pending_at"
+
+	// authSessionKeyPendingRemember stores the key used to store if the user asked to be remembered, once logged in,
+	// in the session
+	authSessionKeyPendingRemember = "pending_remember"
+
+	// recoveryCodeLength stores the length of generated recovery codes
+	recoveryCodeLength = 10
+
+	// totpPeriod stores the number of seconds each TOTP code is valid for
+	totpPeriod = 30
+)
+
+// LoginPending marks the session as pending the second factor for a given user, who has provided a valid
+// password, and whether they asked to be remembered. The session is not authenticated until Login is called.
+func (c *AuthClient) LoginPending(ctx echo.Context, userID int, remember bool) error {
+	sess, err := session.Get(ctx, authSessionName)
+	if err != nil {
+		return err
+	}
+	sess.Values[authSessionKeyPendingUserID] = userID
+	sess.Values[authSessionKeyPendingAt] = time.Now().Unix()
+	sess.Values[authSessionKeyPendingRemember] = remember
+	sess.Values[authSessionKeyAuthenticated] = false
+	return sess.Save(ctx.Request(), ctx.Response())
+}
+
+// GetPendingUserID returns the ID of the user that the session is pending the second factor for, if the session is
+// pending and has not expired
+func (c *AuthClient) GetPendingUserID(ctx echo.Context) (int, error) {
+	sess, err := session.Get(ctx, authSessionName)
+	if err != nil {
+		return 0, err
+	}
+
+	userID, ok := sess.Values[authSessionKeyPendingUserID].(int)
+	if !ok {
+		return 0, NotAuthenticatedError{}
+	}
+
+	at, _ := sess.Values[authSessionKeyPendingAt].(int64)
+	if time.Since(time.Unix(at, 0)) > c.config.App.TOTP.PendingExpiration {
+		return 0, NotAuthenticatedError{}
+	}
+
+	return userID, nil
+}
+
+// PendingRemember returns whether the user that the session is pending the second factor for asked to be remembered
+func (c *AuthClient) PendingRemember(ctx echo.Context) bool {
+	sess, err := session.Get(ctx, authSessionName)
+	if err != nil {
+		return false
+	}
+	remember, _ := sess.Values[authSessionKeyPendingRemember].(bool)
+	return remember
+}
+
+// GetPendingUser returns the user that the session is pending the second factor for, if the session is pending
+// and has not expired
+func (c *AuthClient) GetPendingUser(ctx echo.Context) (*ent.User, error) {
+	userID, err := c.GetPendingUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.orm.User.Get(ctx.Request().Context(), userID)
+}
+
+// GenerateTOTPKey generates a new TOTP key for a given user to add to their authenticator app
+func (c *AuthClient) GenerateTOTPKey(usr *ent.User) (*otp.Key, error) {
+	return totp.Generate(totp.GenerateOpts{
+		Issuer:      c.config.App.Name,
+		AccountName: usr.Email,
+	})
+}
+
+// ValidateTOTP checks if a given code is currently valid for a given user's TOTP secret. The time step of each
+// accepted code is stored so that a code, or any code from an earlier step, cannot be used again.
+func (c *AuthClient) ValidateTOTP(ctx echo.Context, usr *ent.User, code string) (bool, error) {
+	if usr.TotpSecret == "" {
+		return false, nil
+	}
+
+	secret, err := c.Decrypt(usr.TotpSecret)
+	if err != nil {
+		return false, err
+	}
+
+	// Check each step within the allowed skew, latest first, to find the one the code belongs to.
+	code = strings.TrimSpace(code)
+	now := time.Now()
+	opts := totp.ValidateOpts{
+		Period:    totpPeriod,
+		Digits:    otp.DigitsSix,
+		Algorithm: otp.AlgorithmSHA1,
+	}
+	for skew := 1; skew >= -1; skew-- {
+		t := now.Add(time.Duration(skew*totpPeriod) * time.Second)
+		valid, err := totp.ValidateCustom(code, secret, t, opts)
+		if err != nil || !valid {
+			continue
+		}
+
+		// Only record the step if it is later than the last one accepted, which also prevents concurrent requests
+		// from using the same code.
+		step := t.Unix() / totpPeriod
+		n, err := c.orm.User.
+			Update().
+			Where(
+				user.ID(usr.ID),
+				user.Or(
+					user.TotpLastStepIsNil(),
+					user.TotpLastStepLT(step),
+				),
+			).
+			SetTotpLastStep(step).
+			Save(ctx.Request().Context())
+		if err != nil {
+			return false, err
+		}
+
+		return n > 0, nil
+	}
+
+	return false, nil
+}
+
+// EnableTOTP stores a given TOTP secret, encrypted, for a given user, which enables two-factor authentication, and
+// replaces the user's recovery codes. The new recovery codes are returned since only hashes of them are stored.
+func (c *AuthClient) EnableTOTP(ctx echo.Context, userID int, secret string) ([]string, error) {
+	encrypted, err := c.Encrypt(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, c.config.App.TOTP.RecoveryCodes)
+	for i := range codes {
+		if codes[i], err = c.RandomToken(recoveryCodeLength); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := c.orm.Tx(ctx.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+
+	err = func() error {
+		err := tx.User.
+			UpdateOneID(userID).
+			SetTotpSecret(encrypted).
+			ClearTotpLastStep().
+			Exec(ctx.Request().Context())
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.RecoveryCode.
+			Delete().
+			Where(recoverycode.UserID(userID)).
+			Exec(ctx.Request().Context())
+		if err != nil {
+			return err
+		}
+
+		builders := make([]*ent.RecoveryCodeCreate, len(codes))
+		for i, code := range codes {
+			builders[i] = tx.RecoveryCode.
+				Create().
+				SetCode(code).
+				SetUserID(userID)
+		}
+
+		return tx.RecoveryCode.
+			CreateBulk(builders...).
+			Exec(ctx.Request().Context())
+	}()
+
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	return codes, tx.Commit()
+}
+
+// DisableTOTP removes the TOTP secret and the recovery codes of a given user, which disables two-factor
+// authentication.
+func (c *AuthClient) DisableTOTP(ctx echo.Context, userID int) error {
+	err := c.orm.User.
+		UpdateOneID(userID).
+		ClearTotpSecret().
+		ClearTotpLastStep().
+		Exec(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	_, err = c.orm.RecoveryCode.
+		Delete().
+		Where(recoverycode.UserID(userID)).
+		Exec(ctx.Request().Context())
+
+	return err
+}
+
+// UseRecoveryCode checks if a given code matches one of the recovery codes of a given user. Since only hashes of
+// the codes are stored, each must be checked, exactly like password tokens. A matching code is deleted so it cannot
+// be used again.
+func (c *AuthClient) UseRecoveryCode(ctx echo.Context, userID int, code string) (bool, error) {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if len(code) != recoveryCodeLength {
+		return false, nil
+	}
+
+	codes, err := c.orm.RecoveryCode.
+		Query().
+		Where(recoverycode.UserID(userID)).
+		All(ctx.Request().Context())
+	if err != nil {
+		return false, err
+	}
+
+	for _, rc := range codes {
+		if c.CheckPassword(code, rc.Code) == nil {
+			err = c.orm.RecoveryCode.
+				DeleteOne(rc).
+				Exec(ctx.Request().Context())
+			return err == nil, err
+		}
+	}
+
+	return false, nil
+}
+
+// Encrypt encrypts a given value using AES-GCM with a key derived from the encryption key stored in configuration
+func (c *AuthClient) Encrypt(value string) (string, error) {
+	gcm, err := c.aead()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(value), nil)), nil
+}
+
+// Decrypt decrypts a value which was encrypted with Encrypt
+func (c *AuthClient) Decrypt(value string) (string, error) {
+	gcm, err := c.aead()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return "", err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return "", errors.New("encrypted value is too short")
+	}
+
+	out, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
+
+// aead returns the AEAD cipher used to encrypt and decrypt values.
+func (c *AuthClient) aead() (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte(c.config.App.EncryptionKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/session"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+	"github.com/pquerna/otp/totp"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClient_Encrypt(t *testing.T) {
+	encrypted, err := c.Auth.Encrypt("secret")
+	require.NoError(t, err)
+	assert.NotEqual(t, "secret", encrypted)
+
+	decrypted, err := c.Auth.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", decrypted)
+
+	_, err = c.Auth.Decrypt(encrypted[:len(encrypted)-2])
+	assert.Error(t, err)
+
+	_, err = c.Auth.Decrypt("abc")
+	assert.Error(t, err)
+}
+
+func TestAuthClient_LoginPending(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+	tests.InitSession(ctx)
+
+	_, err := c.Auth.GetPendingUserID(ctx)
+	assert.Equal(t, NotAuthenticatedError{}, err)
+
+	require.NoError(t, c.Auth.LoginPending(ctx, usr.ID, true))
+
+	userID, err := c.Auth.GetPendingUserID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, usr.ID, userID)
+	assert.True(t, c.Auth.PendingRemember(ctx))
+
+	// Pending sessions are not authenticated
+	_, err = c.Auth.GetAuthenticatedUserID(ctx)
+	assert.Equal(t, NotAuthenticatedError{}, err)
+
+	// Pending sessions expire
+	sess, err := session.Get(ctx, authSessionName)
+	require.NoError(t, err)
+	sess.Values[authSessionKeyPendingAt] = time.Now().Add(-c.Config.App.TOTP.PendingExpiration - time.Second).Unix()
+	_, err = c.Auth.GetPendingUserID(ctx)
+	assert.Equal(t, NotAuthenticatedError{}, err)
+
+	// Logging in completes the pending session
+	require.NoError(t, c.Auth.LoginPending(ctx, usr.ID, true))
+	require.NoError(t, c.Auth.Login(ctx, usr.ID))
+
+	_, err = c.Auth.GetPendingUserID(ctx)
+	assert.Equal(t, NotAuthenticatedError{}, err)
+	assert.False(t, c.Auth.PendingRemember(ctx))
+
+	userID, err = c.Auth.GetAuthenticatedUserID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, usr.ID, userID)
+}
+
+func TestAuthClient_TOTP(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	ctx, _ := tests.NewContext(c.Web, "/")
+
+	// Users without a secret cannot be validated
+	valid, err := c.Auth.ValidateTOTP(ctx, u, "123456")
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	key, err := c.Auth.GenerateTOTPKey(u)
+	require.NoError(t, err)
+	assert.Equal(t, u.Email, key.AccountName())
+	assert.Equal(t, c.Config.App.Name, key.Issuer())
+
+	codes, err := c.Auth.EnableTOTP(ctx, u.ID, key.Secret())
+	require.NoError(t, err)
+	assert.Len(t, codes, c.Config.App.TOTP.RecoveryCodes)
+
+	// The secret is stored encrypted
+	u, err = c.ORM.User.Get(ctx.Request().Context(), u.ID)
+	require.NoError(t, err)
+	assert.NotEmpty(t, u.TotpSecret)
+	assert.NotEqual(t, key.Secret(), u.TotpSecret)
+
+	code, err := totp.GenerateCode(key.Secret(), time.Now())
+	require.NoError(t, err)
+	valid, err = c.Auth.ValidateTOTP(ctx, u, code)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = c.Auth.ValidateTOTP(ctx, u, "000000")
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	// Codes cannot be used again, nor can codes from an earlier step
+	valid, err = c.Auth.ValidateTOTP(ctx, u, code)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	code, err = totp.GenerateCode(key.Secret(), time.Now().Add(-30*time.Second))
+	require.NoError(t, err)
+	valid, err = c.Auth.ValidateTOTP(ctx, u, code)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	// Codes from a later step are still accepted
+	code, err = totp.GenerateCode(key.Secret(), time.Now().Add(30*time.Second))
+	require.NoError(t, err)
+	valid, err = c.Auth.ValidateTOTP(ctx, u, code)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	// Recovery codes can only be used once
+	valid, err = c.Auth.UseRecoveryCode(ctx, u.ID, codes[0])
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = c.Auth.UseRecoveryCode(ctx, u.ID, codes[0])
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	valid, err = c.Auth.UseRecoveryCode(ctx, u.ID, "invalid")
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	// Disabling removes the secret and the recovery codes
+	require.NoError(t, c.Auth.DisableTOTP(ctx, u.ID))
+
+	u, err = c.ORM.User.Get(ctx.Request().Context(), u.ID)
+	require.NoError(t, err)
+	assert.Empty(t, u.TotpSecret)
+
+	valid, err = c.Auth.UseRecoveryCode(ctx, u.ID, codes[1])
+	require.NoError(t, err)
+	assert.False(t, valid)
+}
@@ -1,13 +1,18 @@
 package services
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/maypok86/otter"
+	"golang.org/x/sync/singleflight"
 )
 
 // ErrCacheMiss indicates that the requested key does not exist in the cache
@@ -16,23 +21,56 @@ var ErrCacheMiss = errors.New("cache miss")
 type (
 	// CacheStore provides an interface for cache storage
 	CacheStore interface {
-		// get attempts to get a cached value
-		get(context.Context, *CacheGetOp) (any, error)
+		// get attempts to get an encoded cached value
+		get(context.Context, *CacheGetOp) ([]byte, error)
 
-		// set attempts to set an entry in the cache
-		set(context.Context, *CacheSetOp) error
+		// set attempts to set an entry in the cache with given encoded data
+		set(context.Context, *CacheSetOp, []byte) error
 
-		// flush removes a given key and/or tags from the cache
+		// flush removes a given key, an entire group and/or tags from the cache
 		flush(context.Context, *CacheFlushOp) error
 
 		// close shuts down the cache storage
 		close()
 	}
 
+	// cacheTagIndexStore is implemented by cache stores that maintain their own tag index
+	cacheTagIndexStore interface {
+		// tagIndexStats returns statistics about the tag index
+		tagIndexStats() CacheTagIndexStats
+	}
+
+	// cacheTagLister is implemented by cache stores that can list the tags in use
+	cacheTagLister interface {
+		// listTags returns all tags in use
+		listTags(context.Context) ([]CacheTag, error)
+	}
+
+	// CacheTag contains information about a cache tag in use.
+	CacheTag struct {
+		// Name is the name of the tag.
+		Name string
+
+		// Keys is the amount of keys with the tag.
+		Keys int
+	}
+
 	// CacheClient is the client that allows you to interact with the cache
 	CacheClient struct {
 		// store holds the Cache storage
 		store CacheStore
+
+		// codec encodes and decodes cached values
+		codec CacheCodec
+
+		// loads collapses concurrent loads of the same key in to a single call
+		loads singleflight.Group
+
+		// refreshing marks the keys of stale values which are being reloaded in the background
+		refreshing sync.Map
+
+		// metrics tracks usage of the cache
+		metrics *cacheMetrics
 	}
 
 	// CacheSetOp handles chaining a set operation
@@ -57,33 +95,35 @@ type (
 		client *CacheClient
 		key    string
 		group  string
+		all    bool
 		tags   []string
 	}
 
 	// inMemoryCacheStore is a cache store implementation in memory
 	inMemoryCacheStore struct {
-		store    *otter.CacheWithVariableTTL[string, any]
+		store    *otter.CacheWithVariableTTL[string, []byte]
 		tagIndex *tagIndex
-	}
+		evicted  func(key string, cause CacheEvictionCause)
 
-	// tagIndex maintains an index to support cache tags for in-memory cache stores.
-	// There is a performance and memory impact to using cache tags since set and get operations using tags will require
-	// locking, and we need to keep track of this index in order to keep everything in sync.
-	// If using something like Redis for caching, you can leverage sets to store the index.
-	// Cache tags can be useful and convenient, so you should decide if your app benefits enough from this.
-	// As it stands here, there is no limiting how much memory this will consume and it will track all keys
-	// and tags added and removed from the cache. You could store these in the cache itself but allowing these to
-	// be evicted poses challenges.
-	tagIndex struct {
-		sync.Mutex
-		tags map[string]map[string]struct{} // tag->keys
-		keys map[string]map[string]struct{} // key->tags
+		// versions holds the current version of each group that has been flushed entirely
+		versions   map[string]uint64
+		versionsMu sync.RWMutex
 	}
 )
 
-// NewCacheClient creates a new cache client
-func NewCacheClient(store CacheStore) *CacheClient {
-	return &CacheClient{store: store}
+// NewCacheClient creates a new cache client which encodes values with a given codec
+func NewCacheClient(store CacheStore, codec CacheCodec) *CacheClient {
+	c := &CacheClient{
+		store:   store,
+		codec:   codec,
+		metrics: newCacheMetrics(),
+	}
+
+	if n, ok := store.(cacheEvictionNotifier); ok {
+		n.onEviction(c.metrics.eviction)
+	}
+
+	return c
 }
 
 // Close closes the connection to the cache
@@ -91,6 +131,43 @@ func (c *CacheClient) Close() {
 	c.store.close()
 }
 
+// Stats returns statistics about the usage of the cache since the client was created.
+func (c *CacheClient) Stats() CacheStats {
+	s := c.metrics.stats()
+	if ts, ok := c.TagIndexStats(); ok {
+		s.TagIndex = &ts
+	}
+	return s
+}
+
+// Tags returns the tags currently in use, sorted by name, if the store supports listing them.
+func (c *CacheClient) Tags(ctx context.Context) ([]CacheTag, error) {
+	l, ok := c.store.(cacheTagLister)
+	if !ok {
+		return nil, errors.New("cache store does not support listing tags")
+	}
+
+	tags, err := l.listTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(tags, func(a, b CacheTag) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return tags, nil
+}
+
+// TagIndexStats returns statistics about the tag index of the cache store, if the store maintains one.
+// Stores such as Redis keep the index within the store itself and will return false.
+func (c *CacheClient) TagIndexStats() (CacheTagIndexStats, bool) {
+	if s, ok := c.store.(cacheTagIndexStore); ok {
+		return s.tagIndexStats(), true
+	}
+	return CacheTagIndexStats{}, false
+}
+
 // Set creates a cache set operation
 func (c *CacheClient) Set() *CacheSetOp {
 	return &CacheSetOp{
@@ -112,14 +189,41 @@ func (c *CacheClient) Flush() *CacheFlushOp {
 	}
 }
 
-// cacheKey formats a cache key with an optional group
+// cacheKey formats a cache key with an optional group.
+// The group is prefixed with its length so the keys of one group never begin with the name of another, such as
+// "a" and "a::b", which allows the keys of a group to be matched by prefix.
 func (c *CacheClient) cacheKey(group, key string) string {
 	if group != "" {
-		return fmt.Sprintf("%s::%s", group, key)
+		return fmt.Sprintf("%d:%s::%s", len(group), group, key)
 	}
 	return key
 }
 
+// cacheKeyGroup extracts the group from a cache key formatted by cacheKey, if it has one.
+func cacheKeyGroup(key string) string {
+	length, rest, found := strings.Cut(key, ":")
+	if !found {
+		return ""
+	}
+
+	n, err := strconv.Atoi(length)
+	if err != nil || n <= 0 || !strings.HasPrefix(rest[min(n, len(rest)):], "::") {
+		return ""
+	}
+
+	return rest[:n]
+}
+
+// groupKey formats a cache key within a given version of a group.
+// The version is always included, even for the initial version of a group, so a key which itself looks like a
+// version, such as "v1::x", cannot be mistaken for a key in that version of the group.
+func (c *CacheClient) groupKey(group string, version uint64, key string) string {
+	if group == "" {
+		return key
+	}
+	return c.cacheKey(group, fmt.Sprintf("v%d::%s", version, key))
+}
+
 // Key sets the cache key
 func (c *CacheSetOp) Key(key string) *CacheSetOp {
 	c.key = key
@@ -161,7 +265,17 @@ func (c *CacheSetOp) Save(ctx context.Context) error {
 		return errors.New("no cache expiration specified")
 	}
 
-	return c.client.store.set(ctx, c)
+	data, err := c.client.codec.Marshal(c.data)
+	if err != nil {
+		return fmt.Errorf("failed to encode cache data: %w", err)
+	}
+
+	if err := c.client.store.set(ctx, c, data); err != nil {
+		return err
+	}
+
+	c.client.metrics.group(c.group).sets.Add(1)
+	return nil
 }
 
 // Key sets the cache key
@@ -176,13 +290,30 @@ func (c *CacheGetOp) Group(group string) *CacheGetOp {
 	return c
 }
 
-// Fetch fetches the data from the cache
-func (c *CacheGetOp) Fetch(ctx context.Context) (any, error) {
+// Fetch fetches the data from the cache and decodes it in to a given destination, which must be a pointer.
+// Values cached by a CacheLoadOp should be fetched using one.
+func (c *CacheGetOp) Fetch(ctx context.Context, dest any) error {
 	if c.key == "" {
-		return nil, errors.New("no cache key specified")
+		return errors.New("no cache key specified")
+	}
+
+	data, err := c.client.store.get(ctx, c)
+
+	switch {
+	case err == nil:
+		c.client.metrics.group(c.group).hits.Add(1)
+	case errors.Is(err, ErrCacheMiss):
+		c.client.metrics.group(c.group).misses.Add(1)
+		return err
+	default:
+		return err
 	}
 
-	return c.client.store.get(ctx, c)
+	if err := c.client.codec.Unmarshal(data, dest); err != nil {
+		return fmt.Errorf("failed to decode cache data: %w", err)
+	}
+
+	return nil
 }
 
 // Key sets the cache key
@@ -197,6 +328,13 @@ func (c *CacheFlushOp) Group(group string) *CacheFlushOp {
 	return c
 }
 
+// All flushes every key in the cache group rather than a single key.
+// This is done by bumping the version of the group, so all of its existing keys are invalidated at once.
+func (c *CacheFlushOp) All() *CacheFlushOp {
+	c.all = true
+	return c
+}
+
 // Tags sets the cache tags
 func (c *CacheFlushOp) Tags(tags ...string) *CacheFlushOp {
 	c.tags = tags
@@ -205,19 +343,45 @@ func (c *CacheFlushOp) Tags(tags ...string) *CacheFlushOp {
 
 // Execute flushes the data from the cache
 func (c *CacheFlushOp) Execute(ctx context.Context) error {
-	return c.client.store.flush(ctx, c)
+	if c.all && c.group == "" {
+		return errors.New("no cache group specified")
+	}
+
+	if err := c.client.store.flush(ctx, c); err != nil {
+		return err
+	}
+
+	if c.key != "" || c.all {
+		c.client.metrics.group(c.group).flushes.Add(1)
+	}
+	if len(c.tags) > 0 {
+		c.client.metrics.tagFlushes.Add(1)
+	}
+
+	return nil
 }
 
 // newInMemoryCache creates a new in-memory CacheStore
 func newInMemoryCache(capacity int) (CacheStore, error) {
 	s := &inMemoryCacheStore{
-		tagIndex: newTagIndex(),
+		tagIndex: newTagIndex(capacity),
+		versions: make(map[string]uint64),
 	}
 
-	store, err := otter.MustBuilder[string, any](capacity).
+	store, err := otter.MustBuilder[string, []byte](capacity).
 		WithVariableTTL().
-		DeletionListener(func(key string, value any, cause otter.DeletionCause) {
-			s.tagIndex.purgeKeys(key)
+		DeletionListener(func(key string, value []byte, cause otter.DeletionCause) {
+			// Explicit deletions are purged from the tag index when flushing, and replaced entries are still cached,
+			// so only entries removed by the cache itself need to be purged here. Since this is called asynchronously,
+			// purging in the other cases could remove tags that have since been set on the key.
+			switch cause {
+			case otter.Expired:
+				s.tagIndex.purgeKeys(key)
+				s.notifyEviction(key, CacheEvictionExpired)
+			case otter.Size:
+				s.tagIndex.purgeKeys(key)
+				s.notifyEviction(key, CacheEvictionSize)
+			}
 		}).
 		Build()
 
@@ -227,11 +391,20 @@ func newInMemoryCache(capacity int) (CacheStore, error) {
 
 	s.store = &store
 
+	// Keys evicted from the tag index must also be removed from the cache, otherwise they can no longer be flushed
+	// by their tags.
+	s.tagIndex.onEvict = func(keys ...string) {
+		for _, key := range keys {
+			s.store.Delete(key)
+			s.notifyEviction(key, CacheEvictionTagIndex)
+		}
+	}
+
 	return s, nil
 }
 
-func (s *inMemoryCacheStore) get(_ context.Context, op *CacheGetOp) (any, error) {
-	v, exists := s.store.Get(op.client.cacheKey(op.group, op.key))
+func (s *inMemoryCacheStore) get(_ context.Context, op *CacheGetOp) ([]byte, error) {
+	v, exists := s.store.Get(s.key(op.client, op.group, op.key))
 
 	if !exists {
 		return nil, ErrCacheMiss
@@ -240,12 +413,12 @@ func (s *inMemoryCacheStore) get(_ context.Context, op *CacheGetOp) (any, error)
 	return v, nil
 }
 
-func (s *inMemoryCacheStore) set(_ context.Context, op *CacheSetOp) error {
-	key := op.client.cacheKey(op.group, op.key)
+func (s *inMemoryCacheStore) set(_ context.Context, op *CacheSetOp, data []byte) error {
+	key := s.key(op.client, op.group, op.key)
 
 	added := s.store.Set(
 		key,
-		op.data,
+		data,
 		op.expiration,
 	)
 
@@ -263,8 +436,11 @@ func (s *inMemoryCacheStore) set(_ context.Context, op *CacheSetOp) error {
 func (s *inMemoryCacheStore) flush(_ context.Context, op *CacheFlushOp) error {
 	keys := make([]string, 0)
 
-	if key := op.client.cacheKey(op.group, op.key); key != "" {
-		keys = append(keys, key)
+	switch {
+	case op.all:
+		keys = append(keys, s.flushGroup(op.client, op.group)...)
+	case op.key != "":
+		keys = append(keys, s.key(op.client, op.group, op.key))
 	}
 
 	if len(op.tags) > 0 {
@@ -280,72 +456,62 @@ func (s *inMemoryCacheStore) flush(_ context.Context, op *CacheFlushOp) error {
 	return nil
 }
 
-func (s *inMemoryCacheStore) close() {
-	s.store.Close()
-}
-
-func newTagIndex() *tagIndex {
-	return &tagIndex{
-		tags: make(map[string]map[string]struct{}),
-		keys: make(map[string]map[string]struct{}),
-	}
-}
-
-func (i *tagIndex) setTags(key string, tags ...string) {
-	i.Lock()
-	defer i.Unlock()
-
-	if _, exists := i.keys[key]; !exists {
-		i.keys[key] = make(map[string]struct{})
+// key returns the cache key for a given key within the current version of a group.
+func (s *inMemoryCacheStore) key(client *CacheClient, group, key string) string {
+	if group == "" {
+		return key
 	}
 
-	for _, tag := range tags {
-		if _, exists := i.tags[tag]; !exists {
-			i.tags[tag] = make(map[string]struct{})
-		}
-		i.tags[tag][key] = struct{}{}
-		i.keys[key][tag] = struct{}{}
-	}
+	s.versionsMu.RLock()
+	defer s.versionsMu.RUnlock()
+	return client.groupKey(group, s.versions[group], key)
 }
 
-func (i *tagIndex) purgeTags(tags ...string) []string {
-	i.Lock()
-	defer i.Unlock()
+// flushGroup bumps the version of a given group, which atomically invalidates all of its keys, and returns the keys
+// of the previous versions of the group that were still cached so they can be removed.
+func (s *inMemoryCacheStore) flushGroup(client *CacheClient, group string) []string {
+	s.versionsMu.Lock()
+	s.versions[group]++
+	current := client.groupKey(group, s.versions[group], "")
+	s.versionsMu.Unlock()
 
+	prefix := client.cacheKey(group, "")
 	keys := make([]string, 0)
+	s.store.Range(func(key string, _ []byte) bool {
+		if strings.HasPrefix(key, prefix) && !strings.HasPrefix(key, current) {
+			keys = append(keys, key)
+		}
+		return true
+	})
 
-	for _, tag := range tags {
-		if tagKeys, exists := i.tags[tag]; exists {
-			delete(i.tags, tag)
+	return keys
+}
 
-			for key := range tagKeys {
-				delete(i.keys[key], tag)
-				if len(i.keys[key]) == 0 {
-					delete(i.keys, key)
-				}
+// clear removes all entries from the store.
+func (s *inMemoryCacheStore) clear() {
+	s.store.Clear()
+	s.tagIndex.clear()
+}
 
-				keys = append(keys, key)
-			}
-		}
-	}
+func (s *inMemoryCacheStore) close() {
+	s.store.Close()
+}
 
-	return keys
+func (s *inMemoryCacheStore) tagIndexStats() CacheTagIndexStats {
+	return s.tagIndex.stats()
 }
 
-func (i *tagIndex) purgeKeys(keys ...string) {
-	i.Lock()
-	defer i.Unlock()
+func (s *inMemoryCacheStore) listTags(_ context.Context) ([]CacheTag, error) {
+	return s.tagIndex.list(), nil
+}
 
-	for _, key := range keys {
-		if keyTags, exists := i.keys[key]; exists {
-			delete(i.keys, key)
-
-			for tag := range keyTags {
-				delete(i.tags[tag], key)
-				if len(i.tags[tag]) == 0 {
-					delete(i.tags, tag)
-				}
-			}
-		}
+func (s *inMemoryCacheStore) onEviction(fn func(key string, cause CacheEvictionCause)) {
+	s.evicted = fn
+}
+
+// notifyEviction notifies the registered eviction callback, if one, that a key was evicted.
+func (s *inMemoryCacheStore) notifyEviction(key string, cause CacheEvictionCause) {
+	if s.evicted != nil {
+		s.evicted(key, cause)
 	}
 }
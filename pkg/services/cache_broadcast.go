@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/mikestefanello/pagoda/pkg/log"
+)
+
+const (
+	// postgresCacheChannel is the Postgres notification channel that cache flushes are broadcast on.
+	postgresCacheChannel = "pagoda_cache_flush"
+
+	// postgresCacheRetryDelay is how long to wait before listening again after the connection is lost.
+	postgresCacheRetryDelay = 5 * time.Second
+)
+
+// postgresCacheBroadcaster is a cacheBroadcaster which uses Postgres LISTEN/NOTIFY.
+// A dedicated connection is taken from the database pool in order to listen for notifications. Since notifications
+// sent while the connection is lost cannot be recovered, subscribers are sent a message to clear their entire L1
+// store each time the connection is established.
+type postgresCacheBroadcaster struct {
+	db      *sql.DB
+	channel string
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// newPostgresCacheBroadcaster creates a new postgresCacheBroadcaster using a given pgx database connection.
+func newPostgresCacheBroadcaster(db *sql.DB) *postgresCacheBroadcaster {
+	return &postgresCacheBroadcaster{
+		db:      db,
+		channel: postgresCacheChannel,
+		done:    make(chan struct{}),
+	}
+}
+
+func (b *postgresCacheBroadcaster) publish(ctx context.Context, msg cacheFlushMessage) error {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", b.channel, string(payload))
+	return err
+}
+
+func (b *postgresCacheBroadcaster) subscribe(fn func(cacheFlushMessage)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	b.cancel = cancel
+
+	go func() {
+		defer close(b.done)
+
+		for {
+			err := b.listen(ctx, fn)
+			if ctx.Err() != nil {
+				return
+			}
+
+			log.Default().Error("cache broadcast listener disconnected",
+				"error", err,
+			)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(postgresCacheRetryDelay):
+			}
+		}
+	}()
+}
+
+// listen listens for notifications until the context is cancelled or the connection fails.
+func (b *postgresCacheBroadcaster) listen(ctx context.Context, fn func(cacheFlushMessage)) error {
+	conn, err := b.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var listenErr error
+	err = conn.Raw(func(driverConn any) error {
+		c, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			listenErr = errors.New("database connection is not a pgx connection")
+			return listenErr
+		}
+
+		listenErr = b.wait(ctx, c.Conn(), fn)
+
+		// The connection is still listening on the channel, so it must not be returned to the pool.
+		return driver.ErrBadConn
+	})
+
+	if listenErr != nil {
+		return listenErr
+	}
+	return err
+}
+
+// wait listens on the channel of a given connection and executes a callback for each notification received.
+func (b *postgresCacheBroadcaster) wait(ctx context.Context, conn *pgx.Conn, fn func(cacheFlushMessage)) error {
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{b.channel}.Sanitize()); err != nil {
+		return err
+	}
+
+	// Notifications sent while not listening were missed, so anything cached locally may be stale.
+	fn(cacheFlushMessage{Clear: true})
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var msg cacheFlushMessage
+		if err := json.Unmarshal([]byte(n.Payload), &msg); err != nil {
+			log.Default().Error("failed to decode cache broadcast",
+				"error", fmt.Errorf("%w: %s", err, n.Payload),
+			)
+			continue
+		}
+
+		fn(msg)
+	}
+}
+
+func (b *postgresCacheBroadcaster) close() {
+	if b.cancel != nil {
+		b.cancel()
+		<-b.done
+	}
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type (
+	// CacheCodec encodes values before they are stored in the cache and decodes them when they are retrieved.
+	// Since values are copied in and out of the cache, they cannot be mutated after they are stored, and they can be
+	// held by stores outside of the application process.
+	CacheCodec interface {
+		// Marshal encodes a given value
+		Marshal(v any) ([]byte, error)
+
+		// Unmarshal decodes data in to a given destination, which must be a pointer
+		Unmarshal(data []byte, v any) error
+	}
+
+	// GobCodec is a CacheCodec which uses encoding/gob.
+	// Values that are stored in fields with interface types must be registered via gob.Register().
+	GobCodec struct{}
+
+	// JSONCodec is a CacheCodec which uses encoding/json.
+	// Only exported fields are encoded.
+	JSONCodec struct{}
+
+	// MsgpackCodec is a CacheCodec which uses MessagePack, a compact binary alternative to JSON.
+	// Only exported fields are encoded.
+	MsgpackCodec struct{}
+)
+
+// Marshal encodes a given value with gob
+func (GobCodec) Marshal(v any) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	if err := gob.NewEncoder(buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes gob data in to a given destination
+func (GobCodec) Unmarshal(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// Marshal encodes a given value with JSON
+func (JSONCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal decodes JSON data in to a given destination
+func (JSONCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+// Marshal encodes a given value with MessagePack
+func (MsgpackCodec) Marshal(v any) ([]byte, error) {
+	return msgpack.Marshal(v)
+}
+
+// Unmarshal decodes MessagePack data in to a given destination
+func (MsgpackCodec) Unmarshal(data []byte, v any) error {
+	return msgpack.Unmarshal(data, v)
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cacheCodecTest struct {
+	Name    string
+	Count   int
+	Tags    []string
+	Created time.Time
+}
+
+func TestCacheCodecs(t *testing.T) {
+	codecs := map[string]CacheCodec{
+		"gob":     GobCodec{},
+		"json":    JSONCodec{},
+		"msgpack": MsgpackCodec{},
+	}
+
+	for name, codec := range codecs {
+		t.Run(name, func(t *testing.T) {
+			store, err := newInMemoryCache(100)
+			require.NoError(t, err)
+			client := NewCacheClient(store, codec)
+			defer client.Close()
+
+			data := cacheCodecTest{
+				Name:    "abc",
+				Count:   3,
+				Tags:    []string{"a", "b"},
+				Created: time.Now().UTC().Truncate(time.Second),
+			}
+
+			err = client.
+				Set().
+				Key("key").
+				Data(data).
+				Expiration(time.Minute).
+				Save(context.Background())
+			require.NoError(t, err)
+
+			// Changing the original value should not change the cached value
+			data.Tags[0] = "c"
+
+			var fromCache cacheCodecTest
+			err = client.
+				Get().
+				Key("key").
+				Fetch(context.Background(), &fromCache)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"a", "b"}, fromCache.Tags)
+			data.Tags[0] = "a"
+			assert.Equal(t, data.Name, fromCache.Name)
+			assert.Equal(t, data.Count, fromCache.Count)
+			assert.True(t, data.Created.Equal(fromCache.Created))
+
+			// Changing the fetched value should not change the cached value
+			fromCache.Tags[0] = "c"
+			v, err := Cached[cacheCodecTest](client).
+				Key("key").
+				Fetch(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, []string{"a", "b"}, v.Tags)
+
+			// Loaded values should be encoded with the codec as well
+			loaded, err := Cached[cacheCodecTest](client).
+				Key("loaded").
+				Expiration(time.Minute).
+				Loader(func(ctx context.Context) (cacheCodecTest, error) {
+					return data, nil
+				}).
+				Fetch(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, data.Name, loaded.Name)
+
+			loaded, err = Cached[cacheCodecTest](client).
+				Key("loaded").
+				Fetch(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, data.Name, loaded.Name)
+			assert.Equal(t, data.Tags, loaded.Tags)
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/log"
+)
+
+// databaseCacheSchema creates the tables used by the database cache store.
+// The tables are unlogged since cached data does not need to survive a crash, which makes writes considerably
+// faster. Tags reference their entries so they are removed along with them.
+const databaseCacheSchema = `
+CREATE UNLOGGED TABLE IF NOT EXISTS cache_entries (
+	key TEXT PRIMARY KEY,
+	value BYTEA NOT NULL,
+	expires_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS cache_entries_expires_at ON cache_entries (expires_at);
+CREATE UNLOGGED TABLE IF NOT EXISTS cache_tags (
+	tag TEXT NOT NULL,
+	key TEXT NOT NULL REFERENCES cache_entries (key) ON DELETE CASCADE,
+	PRIMARY KEY (tag, key)
+);
+CREATE INDEX IF NOT EXISTS cache_tags_key ON cache_tags (key);
+CREATE UNLOGGED TABLE IF NOT EXISTS cache_groups (
+	name TEXT PRIMARY KEY,
+	version BIGINT NOT NULL
+);
+`
+
+// databaseCacheStore is a cache store implementation backed by the Postgres database.
+// Like the Redis store, the entries and the tag index are shared by every instance of the application using the
+// same database. Expired entries are never returned and are periodically removed in the background.
+type databaseCacheStore struct {
+	db     *sql.DB
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// newDatabaseCache creates a new database CacheStore, creating the tables if needed, which removes expired entries
+// at a given interval. If the interval is not positive, expired entries are not removed.
+func newDatabaseCache(db *sql.DB, sweepInterval time.Duration) (CacheStore, error) {
+	if _, err := db.Exec(databaseCacheSchema); err != nil {
+		return nil, fmt.Errorf("failed to create cache tables: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &databaseCacheStore{
+		db:     db,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	if sweepInterval > 0 {
+		go s.sweep(ctx, sweepInterval)
+	} else {
+		close(s.done)
+	}
+
+	return s, nil
+}
+
+func (s *databaseCacheStore) get(ctx context.Context, op *CacheGetOp) ([]byte, error) {
+	key, err := s.key(ctx, op.client, op.group, op.key)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	err = s.db.QueryRowContext(ctx,
+		"SELECT value FROM cache_entries WHERE key = $1 AND expires_at > now()",
+		key,
+	).Scan(&data)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, ErrCacheMiss
+	case err != nil:
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (s *databaseCacheStore) set(ctx context.Context, op *CacheSetOp, data []byte) error {
+	key, err := s.key(ctx, op.client, op.group, op.key)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO cache_entries (key, value, expires_at)
+		VALUES ($1, $2, now() + make_interval(secs => $3))
+		ON CONFLICT (key) DO UPDATE SET value = excluded.value, expires_at = excluded.expires_at`,
+		key,
+		data,
+		op.expiration.Seconds(),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range op.tags {
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO cache_tags (tag, key) VALUES ($1, $2) ON CONFLICT DO NOTHING",
+			tag,
+			key,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *databaseCacheStore) flush(ctx context.Context, op *CacheFlushOp) error {
+	switch {
+	case op.all:
+		if err := s.flushGroup(ctx, op.client, op.group); err != nil {
+			return err
+		}
+	case op.key != "":
+		key, err := s.key(ctx, op.client, op.group, op.key)
+		if err != nil {
+			return err
+		}
+
+		if _, err = s.db.ExecContext(ctx, "DELETE FROM cache_entries WHERE key = $1", key); err != nil {
+			return err
+		}
+	}
+
+	if len(op.tags) > 0 {
+		params := make([]string, len(op.tags))
+		args := make([]any, len(op.tags))
+		for i, tag := range op.tags {
+			params[i] = fmt.Sprintf("$%d", i+1)
+			args[i] = tag
+		}
+
+		_, err := s.db.ExecContext(ctx, fmt.Sprintf(
+			"DELETE FROM cache_entries WHERE key IN (SELECT key FROM cache_tags WHERE tag IN (%s))",
+			strings.Join(params, ", "),
+		), args...)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *databaseCacheStore) listTags(ctx context.Context) ([]CacheTag, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT t.tag, COUNT(*)
+		FROM cache_tags t
+		INNER JOIN cache_entries e ON e.key = t.key
+		WHERE e.expires_at > now()
+		GROUP BY t.tag`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make([]CacheTag, 0)
+	for rows.Next() {
+		var tag CacheTag
+		if err := rows.Scan(&tag.Name, &tag.Keys); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, rows.Err()
+}
+
+func (s *databaseCacheStore) close() {
+	s.cancel()
+	<-s.done
+}
+
+// key returns the cache key for a given key within the current version of a group.
+func (s *databaseCacheStore) key(ctx context.Context, client *CacheClient, group, key string) (string, error) {
+	if group == "" {
+		return key, nil
+	}
+
+	var version uint64
+	err := s.db.QueryRowContext(ctx, "SELECT version FROM cache_groups WHERE name = $1", group).Scan(&version)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("failed to get cache group version: %w", err)
+	}
+
+	return client.groupKey(group, version, key), nil
+}
+
+// flushGroup bumps the version of a given group, which atomically invalidates all of its keys, then removes the
+// entries of the previous versions of the group.
+func (s *databaseCacheStore) flushGroup(ctx context.Context, client *CacheClient, group string) error {
+	var version uint64
+	err := s.db.QueryRowContext(ctx, `
+		INSERT INTO cache_groups (name, version) VALUES ($1, 1)
+		ON CONFLICT (name) DO UPDATE SET version = cache_groups.version + 1
+		RETURNING version`,
+		group,
+	).Scan(&version)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx,
+		"DELETE FROM cache_entries WHERE starts_with(key, $1) AND NOT starts_with(key, $2)",
+		client.cacheKey(group, ""),
+		client.groupKey(group, version, ""),
+	)
+
+	return err
+}
+
+// sweep removes expired entries at a given interval until the context is cancelled.
+func (s *databaseCacheStore) sweep(ctx context.Context, interval time.Duration) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			res, err := s.db.ExecContext(ctx, "DELETE FROM cache_entries WHERE expires_at <= now()")
+			switch {
+			case ctx.Err() != nil:
+				return
+			case err != nil:
+				log.Default().Error("failed to remove expired cache entries",
+					"error", err,
+				)
+			default:
+				if n, _ := res.RowsAffected(); n > 0 {
+					log.Default().Debug("removed expired cache entries",
+						"count", n,
+					)
+				}
+			}
+		}
+	}
+}
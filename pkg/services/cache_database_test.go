@@ -0,0 +1,166 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabaseCacheStore(t *testing.T) {
+	store, err := newDatabaseCache(c.Database, 0)
+	require.NoError(t, err)
+	client := NewCacheClient(store, GobCodec{})
+	defer client.Close()
+
+	ctx := context.Background()
+	group := "testdbgroup"
+	key := "testdbkey"
+
+	set := func(group, key, value string, expiration time.Duration, tags ...string) {
+		err := client.
+			Set().
+			Group(group).
+			Key(key).
+			Data(value).
+			Tags(tags...).
+			Expiration(expiration).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	get := func(group, key string) (string, error) {
+		var v string
+		err := client.
+			Get().
+			Group(group).
+			Key(key).
+			Fetch(ctx, &v)
+		return v, err
+	}
+
+	// Cache some data
+	set(group, key, "abc", time.Minute)
+	v, err := get(group, key)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", v)
+
+	// Replace the data
+	set(group, key, "def", time.Minute)
+	v, err = get(group, key)
+	require.NoError(t, err)
+	assert.Equal(t, "def", v)
+
+	// The same key with the wrong group should fail
+	_, err = get("", key)
+	assert.Equal(t, ErrCacheMiss, err)
+
+	// Flush the data
+	err = client.
+		Flush().
+		Group(group).
+		Key(key).
+		Execute(ctx)
+	require.NoError(t, err)
+	_, err = get(group, key)
+	assert.Equal(t, ErrCacheMiss, err)
+
+	// Expired data should not be returned
+	set(group, key, "abc", time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	_, err = get(group, key)
+	assert.Equal(t, ErrCacheMiss, err)
+
+	// Set with tags
+	set(group, "testdbkey2", "abc", time.Hour, "testdbtag1", "testdbtag2")
+	set("", "testdbkey3", "abc", time.Hour, "testdbtag1")
+
+	tags, err := client.Tags(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, tags, CacheTag{Name: "testdbtag1", Keys: 2})
+	assert.Contains(t, tags, CacheTag{Name: "testdbtag2", Keys: 1})
+
+	// Flush one of tags
+	err = client.
+		Flush().
+		Tags("testdbtag1").
+		Execute(ctx)
+	require.NoError(t, err)
+	_, err = get(group, "testdbkey2")
+	assert.Equal(t, ErrCacheMiss, err)
+	_, err = get("", "testdbkey3")
+	assert.Equal(t, ErrCacheMiss, err)
+
+	// The tags should be removed along with the entries
+	tags, err = client.Tags(ctx)
+	require.NoError(t, err)
+	for _, tag := range tags {
+		assert.NotContains(t, []string{"testdbtag1", "testdbtag2"}, tag.Name)
+	}
+
+	// Flush the entire group
+	set(group, "testdbkey4", "abc", time.Hour)
+	set("", "testdbkey4", "abc", time.Hour)
+	set(group+"::nested", "testdbkey4", "abc", time.Hour)
+	err = client.
+		Flush().
+		Group(group).
+		All().
+		Execute(ctx)
+	require.NoError(t, err)
+	_, err = get(group, "testdbkey4")
+	assert.Equal(t, ErrCacheMiss, err)
+	_, err = get("", "testdbkey4")
+	assert.NoError(t, err)
+
+	// Groups nested within the name of the group should not be flushed with it
+	_, err = get(group+"::nested", "testdbkey4")
+	assert.NoError(t, err)
+
+	// Keys which look like the new version of the group should have been flushed rather than mistaken for keys in it
+	var version uint64
+	err = c.Database.QueryRow("SELECT version FROM cache_groups WHERE name = $1", group).Scan(&version)
+	require.NoError(t, err)
+	set(group, fmt.Sprintf("v%d::testdbkey5", version+1), "abc", time.Hour)
+	err = client.
+		Flush().
+		Group(group).
+		All().
+		Execute(ctx)
+	require.NoError(t, err)
+	_, err = get(group, "testdbkey5")
+	assert.Equal(t, ErrCacheMiss, err)
+
+	// Keys set after the flush belong to the new version of the group
+	set(group, "testdbkey4", "def", time.Hour)
+	v, err = get(group, "testdbkey4")
+	require.NoError(t, err)
+	assert.Equal(t, "def", v)
+}
+
+func TestDatabaseCacheStore_Sweep(t *testing.T) {
+	store, err := newDatabaseCache(c.Database, 10*time.Millisecond)
+	require.NoError(t, err)
+	client := NewCacheClient(store, GobCodec{})
+	defer client.Close()
+
+	err = client.
+		Set().
+		Key("testdbsweep").
+		Data("abc").
+		Tags("testdbsweep").
+		Expiration(time.Millisecond).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		var n int
+		err := c.Database.
+			QueryRow("SELECT COUNT(*) FROM cache_entries WHERE key = $1", "testdbsweep").
+			Scan(&n)
+		return err == nil && n == 0
+	}, time.Second, 10*time.Millisecond)
+}
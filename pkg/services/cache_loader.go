@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/log"
+)
+
+type (
+	// CacheLoader is a function that generates a value to be cached when it is not found in the cache.
+	CacheLoader[T any] func(ctx context.Context) (T, error)
+
+	// CacheLoadOp handles chaining a typed get operation which can optionally load and cache the value on a miss.
+	// Concurrent misses for the same key are collapsed in to a single call to the loader.
+	CacheLoadOp[T any] struct {
+		client     *CacheClient
+		key        string
+		group      string
+		expiration time.Duration
+		stale      time.Duration
+		tags       []string
+		loader     CacheLoader[T]
+	}
+
+	// cacheLoadEntry is what is stored in the cache by a CacheLoadOp in order to track when a value becomes stale.
+	cacheLoadEntry[T any] struct {
+		Data       T
+		FreshUntil time.Time
+	}
+)
+
+// Cached creates a typed cache get operation which can optionally load and cache the value on a miss.
+func Cached[T any](client *CacheClient) *CacheLoadOp[T] {
+	return &CacheLoadOp[T]{
+		client: client,
+	}
+}
+
+// Key sets the cache key
+func (c *CacheLoadOp[T]) Key(key string) *CacheLoadOp[T] {
+	c.key = key
+	return c
+}
+
+// Group sets the cache group
+func (c *CacheLoadOp[T]) Group(group string) *CacheLoadOp[T] {
+	c.group = group
+	return c
+}
+
+// Expiration sets the duration that a loaded value is considered fresh
+func (c *CacheLoadOp[T]) Expiration(expiration time.Duration) *CacheLoadOp[T] {
+	c.expiration = expiration
+	return c
+}
+
+// StaleWhileRevalidate sets the duration after a loaded value expires that it can still be served while it is
+// reloaded in the background
+func (c *CacheLoadOp[T]) StaleWhileRevalidate(stale time.Duration) *CacheLoadOp[T] {
+	c.stale = stale
+	return c
+}
+
+// Tags sets the cache tags of loaded values
+func (c *CacheLoadOp[T]) Tags(tags ...string) *CacheLoadOp[T] {
+	c.tags = tags
+	return c
+}
+
+// Loader sets the function used to generate the value when it is not found in the cache.
+// If no loader is provided, ErrCacheMiss will be returned on a miss.
+func (c *CacheLoadOp[T]) Loader(loader CacheLoader[T]) *CacheLoadOp[T] {
+	c.loader = loader
+	return c
+}
+
+// Fetch fetches the value from the cache, using the loader to generate and cache it, if needed.
+// A cached value that cannot be decoded in to type T is treated as a miss.
+func (c *CacheLoadOp[T]) Fetch(ctx context.Context) (T, error) {
+	var empty T
+
+	switch {
+	case c.key == "":
+		return empty, errors.New("no cache key specified")
+	case c.loader != nil && c.expiration == 0:
+		return empty, errors.New("no cache expiration specified")
+	}
+
+	b, err := c.client.store.get(ctx, &CacheGetOp{
+		client: c.client,
+		key:    c.key,
+		group:  c.group,
+	})
+
+	switch {
+	case err == nil:
+		if data, freshUntil, ok := c.decode(b); ok {
+			c.client.metrics.group(c.group).hits.Add(1)
+			if c.loader != nil && !freshUntil.IsZero() && time.Now().After(freshUntil) {
+				// Serve the stale value while it is reloaded in the background.
+				c.refresh(ctx, freshUntil)
+			}
+			return data, nil
+		}
+	case !errors.Is(err, ErrCacheMiss):
+		return empty, err
+	}
+
+	c.client.metrics.group(c.group).misses.Add(1)
+
+	if c.loader == nil {
+		return empty, ErrCacheMiss
+	}
+
+	return c.load(ctx)
+}
+
+// load executes the loader and caches the result, ensuring that only one load for a given key executes at a time.
+// The load is shared by every caller of the key, so it is not canceled along with the caller that started it, though
+// each caller stops waiting for it once their own context is done.
+func (c *CacheLoadOp[T]) load(ctx context.Context) (T, error) {
+	key := c.client.cacheKey(c.group, c.key)
+
+	ch := c.client.loads.DoChan(key, func() (any, error) {
+		ctx := context.WithoutCancel(ctx)
+
+		data, err := c.loader(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = c.client.
+			Set().
+			Group(c.group).
+			Key(c.key).
+			Data(cacheLoadEntry[T]{
+				Data:       data,
+				FreshUntil: time.Now().Add(c.expiration),
+			}).
+			Expiration(c.expiration + c.stale).
+			Tags(c.tags...).
+			Save(ctx)
+
+		if err != nil {
+			log.Default().Error("failed to cache loaded value",
+				"key", key,
+				"error", err,
+			)
+		}
+
+		return data, nil
+	})
+
+	select {
+	case res := <-ch:
+		data, _ := res.Val.(T)
+		return data, res.Err
+	case <-ctx.Done():
+		var empty T
+		return empty, ctx.Err()
+	}
+}
+
+// refresh reloads a stale value, which was fresh until the given time, in the background. Only one refresh for a
+// given key executes at a time, and the cache is checked again before loading so that stale hits which raced with a
+// completed refresh do not reload the value again.
+func (c *CacheLoadOp[T]) refresh(ctx context.Context, freshUntil time.Time) {
+	key := c.client.cacheKey(c.group, c.key)
+
+	if _, busy := c.client.refreshing.LoadOrStore(key, struct{}{}); busy {
+		return
+	}
+
+	go func() {
+		defer c.client.refreshing.Delete(key)
+		ctx := context.WithoutCancel(ctx)
+
+		b, err := c.client.store.get(ctx, &CacheGetOp{
+			client: c.client,
+			key:    c.key,
+			group:  c.group,
+		})
+		if err == nil {
+			if _, current, ok := c.decode(b); ok && !current.Equal(freshUntil) {
+				return
+			}
+		}
+
+		_, _ = c.load(ctx)
+	}()
+}
+
+// decode decodes the value of type T from data fetched from the cache along with the time it is fresh until, if
+// known. Values set directly, without a CacheLoadOp, are supported.
+func (c *CacheLoadOp[T]) decode(b []byte) (T, time.Time, bool) {
+	// Entries cached by a loader always have a freshness time, so anything else was set directly.
+	var entry cacheLoadEntry[T]
+	if err := c.client.codec.Unmarshal(b, &entry); err == nil && !entry.FreshUntil.IsZero() {
+		return entry.Data, entry.FreshUntil, true
+	}
+
+	var data T
+	if err := c.client.codec.Unmarshal(b, &data); err != nil {
+		return data, time.Time{}, false
+	}
+
+	return data, time.Time{}, true
+}
@@ -0,0 +1,213 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCached(t *testing.T) {
+	group := "testloadgroup"
+	key := "testkey"
+
+	// Without a loader, a miss should be returned
+	_, err := Cached[string](c.Cache).
+		Group(group).
+		Key(key).
+		Fetch(context.Background())
+	assert.Equal(t, ErrCacheMiss, err)
+
+	// Values set directly should be returned typed
+	err = c.Cache.
+		Set().
+		Group(group).
+		Key(key).
+		Data("abc").
+		Expiration(time.Minute).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	v, err := Cached[string](c.Cache).
+		Group(group).
+		Key(key).
+		Fetch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "abc", v)
+
+	// A value of a different type should be treated as a miss
+	_, err = Cached[int](c.Cache).
+		Group(group).
+		Key(key).
+		Fetch(context.Background())
+	assert.Equal(t, ErrCacheMiss, err)
+
+	// Load a value on a miss
+	var calls atomic.Int32
+	load := func() (int, error) {
+		return Cached[int](c.Cache).
+			Group(group).
+			Key("testkey2").
+			Expiration(time.Minute).
+			Loader(func(ctx context.Context) (int, error) {
+				calls.Add(1)
+				return 123, nil
+			}).
+			Fetch(context.Background())
+	}
+
+	n, err := load()
+	require.NoError(t, err)
+	assert.Equal(t, 123, n)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// The loaded value should now be cached
+	n, err = load()
+	require.NoError(t, err)
+	assert.Equal(t, 123, n)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// Loader errors should be returned and not cached
+	_, err = Cached[int](c.Cache).
+		Key("testkey3").
+		Expiration(time.Minute).
+		Loader(func(ctx context.Context) (int, error) {
+			return 0, errors.New("failed")
+		}).
+		Fetch(context.Background())
+	assert.EqualError(t, err, "failed")
+}
+
+func TestCached_Singleflight(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := Cached[string](c.Cache).
+				Key("testsingleflight").
+				Expiration(time.Minute).
+				Loader(func(ctx context.Context) (string, error) {
+					calls.Add(1)
+					<-release
+					return "abc", nil
+				}).
+				Fetch(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "abc", v)
+		}()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestCached_SingleflightCanceled(t *testing.T) {
+	key := "testsingleflightcanceled"
+	err := c.Cache.
+		Flush().
+		Key(key).
+		Execute(context.Background())
+	require.NoError(t, err)
+
+	loading := make(chan struct{})
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) (string, error) {
+		return Cached[string](c.Cache).
+			Key(key).
+			Expiration(time.Minute).
+			Loader(func(ctx context.Context) (string, error) {
+				close(loading)
+				<-release
+				return "abc", ctx.Err()
+			}).
+			Fetch(ctx)
+	}
+
+	// Start the load with a caller who then goes away
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, err := fetch(ctx)
+		first <- err
+	}()
+	<-loading
+
+	// While another caller waits for the same load
+	second := make(chan string)
+	go func() {
+		v, err := fetch(context.Background())
+		assert.NoError(t, err)
+		second <- v
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	assert.ErrorIs(t, <-first, context.Canceled)
+
+	close(release)
+	assert.Equal(t, "abc", <-second)
+}
+
+func TestCached_StaleWhileRevalidate(t *testing.T) {
+	key := "teststale"
+
+	// Cache a value which is already stale
+	err := c.Cache.
+		Set().
+		Key(key).
+		Data(cacheLoadEntry[int32]{
+			Data:       1,
+			FreshUntil: time.Now().Add(-time.Second),
+		}).
+		Expiration(time.Minute).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	var calls atomic.Int32
+	loading := make(chan struct{})
+	release := make(chan struct{})
+	fetch := func() (int32, error) {
+		return Cached[int32](c.Cache).
+			Key(key).
+			Expiration(time.Minute).
+			StaleWhileRevalidate(time.Minute).
+			Loader(func(ctx context.Context) (int32, error) {
+				n := calls.Add(1)
+				loading <- struct{}{}
+				<-release
+				return n + 1, nil
+			}).
+			Fetch(context.Background())
+	}
+
+	// The stale value should be returned while it reloads in the background
+	v, err := fetch()
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), v)
+	<-loading
+
+	// Further stale hits should not reload the value again
+	for range 10 {
+		v, err = fetch()
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), v)
+	}
+
+	close(release)
+	assert.Eventually(t, func() bool {
+		v, err = fetch()
+		return err == nil && v == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(1), calls.Load())
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// CacheEvictionCause indicates why an entry was evicted from the cache.
+type CacheEvictionCause int
+
+const (
+	// CacheEvictionExpired indicates that the entry expired.
+	CacheEvictionExpired CacheEvictionCause = iota
+
+	// CacheEvictionSize indicates that the entry was removed because the cache was full.
+	CacheEvictionSize
+
+	// CacheEvictionTagIndex indicates that the entry was removed because the tag index was full.
+	CacheEvictionTagIndex
+
+	// cacheEvictionCauses is the amount of eviction causes.
+	cacheEvictionCauses
+)
+
+// String returns the name of the eviction cause.
+func (c CacheEvictionCause) String() string {
+	switch c {
+	case CacheEvictionExpired:
+		return "expired"
+	case CacheEvictionSize:
+		return "size"
+	case CacheEvictionTagIndex:
+		return "tag index"
+	default:
+		return "unknown"
+	}
+}
+
+type (
+	// CacheStats contains statistics about the usage of the cache.
+	CacheStats struct {
+		// Groups contains the statistics for each cache group, sorted by name.
+		// Keys without a group are tracked under an empty group name.
+		Groups []CacheGroupStats
+
+		// TagFlushes is the amount of times tags have been flushed.
+		TagFlushes uint64
+
+		// TagIndex contains statistics about the tag index, if the store maintains one.
+		TagIndex *CacheTagIndexStats
+	}
+
+	// CacheGroupStats contains statistics about the usage of a given cache group.
+	CacheGroupStats struct {
+		Group     string
+		Hits      uint64
+		Misses    uint64
+		Sets      uint64
+		Flushes   uint64
+		Evictions map[CacheEvictionCause]uint64
+	}
+
+	// cacheEvictionNotifier is implemented by cache stores that can report when entries are evicted.
+	cacheEvictionNotifier interface {
+		// onEviction registers a callback to be executed when an entry is evicted
+		onEviction(func(key string, cause CacheEvictionCause))
+	}
+
+	// cacheMetrics tracks usage of the cache per group.
+	cacheMetrics struct {
+		mu         sync.RWMutex
+		groups     map[string]*cacheGroupCounters
+		tagFlushes atomic.Uint64
+	}
+
+	// cacheGroupCounters contains the counters for a given cache group.
+	cacheGroupCounters struct {
+		hits      atomic.Uint64
+		misses    atomic.Uint64
+		sets      atomic.Uint64
+		flushes   atomic.Uint64
+		evictions [cacheEvictionCauses]atomic.Uint64
+	}
+)
+
+// HitRatio returns the ratio of gets that were hits, between 0 and 1.
+func (s CacheGroupStats) HitRatio() float64 {
+	if total := s.Hits + s.Misses; total > 0 {
+		return float64(s.Hits) / float64(total)
+	}
+	return 0
+}
+
+// TotalEvictions returns the amount of evictions across all causes.
+func (s CacheGroupStats) TotalEvictions() uint64 {
+	var total uint64
+	for _, n := range s.Evictions {
+		total += n
+	}
+	return total
+}
+
+func newCacheMetrics() *cacheMetrics {
+	return &cacheMetrics{
+		groups: make(map[string]*cacheGroupCounters),
+	}
+}
+
+// group returns the counters for a given group, creating them if needed.
+func (m *cacheMetrics) group(group string) *cacheGroupCounters {
+	m.mu.RLock()
+	g, exists := m.groups[group]
+	m.mu.RUnlock()
+	if exists {
+		return g
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if g, exists = m.groups[group]; !exists {
+		g = new(cacheGroupCounters)
+		m.groups[group] = g
+	}
+	return g
+}
+
+// eviction records an eviction of a given cache key.
+func (m *cacheMetrics) eviction(key string, cause CacheEvictionCause) {
+	if cause < 0 || cause >= cacheEvictionCauses {
+		return
+	}
+
+	m.group(cacheKeyGroup(key)).evictions[cause].Add(1)
+}
+
+// stats returns the current statistics for all groups.
+func (m *cacheMetrics) stats() CacheStats {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	s := CacheStats{
+		Groups:     make([]CacheGroupStats, 0, len(m.groups)),
+		TagFlushes: m.tagFlushes.Load(),
+	}
+
+	for name, g := range m.groups {
+		gs := CacheGroupStats{
+			Group:     name,
+			Hits:      g.hits.Load(),
+			Misses:    g.misses.Load(),
+			Sets:      g.sets.Load(),
+			Flushes:   g.flushes.Load(),
+			Evictions: make(map[CacheEvictionCause]uint64),
+		}
+
+		for cause := range cacheEvictionCauses {
+			if n := g.evictions[cause].Load(); n > 0 {
+				gs.Evictions[cause] = n
+			}
+		}
+
+		s.Groups = append(s.Groups, gs)
+	}
+
+	slices.SortFunc(s.Groups, func(a, b CacheGroupStats) int {
+		return cmp.Compare(a.Group, b.Group)
+	})
+
+	return s
+}
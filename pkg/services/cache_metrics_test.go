@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheClient_Stats(t *testing.T) {
+	store, err := newInMemoryCache(tagIndexShards)
+	require.NoError(t, err)
+	client := NewCacheClient(store, GobCodec{})
+	defer client.Close()
+
+	set := func(group, key string, tags ...string) {
+		err := client.
+			Set().
+			Group(group).
+			Key(key).
+			Data("abc").
+			Tags(tags...).
+			Expiration(time.Hour).
+			Save(context.Background())
+		require.NoError(t, err)
+	}
+
+	get := func(group, key string) {
+		_ = client.
+			Get().
+			Group(group).
+			Key(key).
+			Fetch(context.Background(), new(string))
+	}
+
+	set("group1", "key1")
+	set("group1", "key2", "tag1")
+	set("", "key3")
+	get("group1", "key1")
+	get("group1", "key1")
+	get("group1", "key3")
+	get("", "key3")
+
+	err = client.Flush().Group("group1").Key("key1").Execute(context.Background())
+	require.NoError(t, err)
+	err = client.Flush().Tags("tag1").Execute(context.Background())
+	require.NoError(t, err)
+
+	stats := client.Stats()
+	require.Len(t, stats.Groups, 2)
+	assert.Equal(t, uint64(1), stats.TagFlushes)
+	require.NotNil(t, stats.TagIndex)
+
+	assert.Equal(t, "", stats.Groups[0].Group)
+	assert.Equal(t, uint64(1), stats.Groups[0].Hits)
+	assert.Equal(t, uint64(0), stats.Groups[0].Misses)
+	assert.Equal(t, uint64(1), stats.Groups[0].Sets)
+
+	assert.Equal(t, "group1", stats.Groups[1].Group)
+	assert.Equal(t, uint64(2), stats.Groups[1].Hits)
+	assert.Equal(t, uint64(1), stats.Groups[1].Misses)
+	assert.Equal(t, uint64(2), stats.Groups[1].Sets)
+	assert.Equal(t, uint64(1), stats.Groups[1].Flushes)
+	assert.InDelta(t, 2.0/3.0, stats.Groups[1].HitRatio(), 0.001)
+
+	// Overflow the tag index to trigger evictions
+	for n := range tagIndexShards * 2 {
+		set("group2", fmt.Sprintf("key%d", n), "tag2")
+	}
+
+	stats = client.Stats()
+	require.Len(t, stats.Groups, 3)
+	evictions := stats.Groups[2].Evictions[CacheEvictionTagIndex]
+	assert.NotZero(t, evictions)
+	assert.Equal(t, evictions, stats.Groups[2].TotalEvictions())
+	assert.Equal(t, evictions, stats.TagIndex.Evictions)
+}
+
+func TestCacheClient_Tags(t *testing.T) {
+	store, err := newInMemoryCache(100)
+	require.NoError(t, err)
+	client := NewCacheClient(store, GobCodec{})
+	defer client.Close()
+
+	for n, tags := range [][]string{{"b", "a"}, {"b"}} {
+		err = client.
+			Set().
+			Key(fmt.Sprint(n)).
+			Data("abc").
+			Tags(tags...).
+			Expiration(time.Hour).
+			Save(context.Background())
+		require.NoError(t, err)
+	}
+
+	tags, err := client.Tags(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []CacheTag{{Name: "a", Keys: 1}, {Name: "b", Keys: 2}}, tags)
+}
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// redisTagKeyPrefix is prepended to each tag name to form the key of the set that holds the tag's cache keys.
+	redisTagKeyPrefix = "pagoda:tag::"
+
+	// redisGroupKeyPrefix is prepended to each group name to form the key that holds the group's current version.
+	redisGroupKeyPrefix = "pagoda:group::"
+)
+
+// redisPurgeTagsScript atomically deletes all cache keys that belong to the provided tag sets, as well as the
+// tag sets themselves, and returns the amount of keys that were removed.
+var redisPurgeTagsScript = redis.NewScript(`
+local purged = 0
+for _, tag in ipairs(KEYS) do
+	for _, key in ipairs(redis.call("SMEMBERS", tag)) do
+		purged = purged + redis.call("DEL", key)
+	end
+	redis.call("DEL", tag)
+end
+return purged
+`)
+
+// redisCacheStore is a cache store implementation backed by Redis.
+// Unlike the in-memory store, cache tags are stored in Redis as sets, so the tag index is shared by every
+// instance of the application using the same Redis database, and tag flushes are coherent across all of them.
+type redisCacheStore struct {
+	client *redis.Client
+}
+
+// newRedisCache creates a new Redis CacheStore using the provided connection options.
+func newRedisCache(opts *redis.Options) (CacheStore, error) {
+	client := redis.NewClient(opts)
+
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to connect to redis: %w", err)
+	}
+
+	return &redisCacheStore{client: client}, nil
+}
+
+// redisOptions returns the Redis connection options for the given configuration.
+func redisOptions(cfg *config.Config) *redis.Options {
+	db := cfg.Cache.Redis.Database
+	if cfg.App.Environment == config.EnvTest {
+		db = cfg.Cache.Redis.TestDatabase
+	}
+
+	return &redis.Options{
+		Addr:     cfg.Cache.Redis.Address,
+		Password: cfg.Cache.Redis.Password,
+		DB:       db,
+	}
+}
+
+func (s *redisCacheStore) get(ctx context.Context, op *CacheGetOp) ([]byte, error) {
+	key, err := s.key(ctx, op.client, op.group, op.key)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.client.Get(ctx, key).Bytes()
+
+	switch {
+	case errors.Is(err, redis.Nil):
+		return nil, ErrCacheMiss
+	case err != nil:
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (s *redisCacheStore) set(ctx context.Context, op *CacheSetOp, data []byte) error {
+	key, err := s.key(ctx, op.client, op.group, op.key)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, key, data, op.expiration)
+
+		for _, tag := range op.tags {
+			tagKey := redisTagKey(tag)
+			pipe.SAdd(ctx, tagKey, key)
+
+			// The tag set must live at least as long as the entry being added to it. NX covers sets that were
+			// just created and GT extends the expiration of existing sets when needed.
+			pipe.ExpireNX(ctx, tagKey, op.expiration)
+			pipe.ExpireGT(ctx, tagKey, op.expiration)
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+func (s *redisCacheStore) flush(ctx context.Context, op *CacheFlushOp) error {
+	switch {
+	case op.all:
+		// Keys of previous versions of the group are left to expire on their own.
+		if err := s.client.Incr(ctx, redisGroupKey(op.group)).Err(); err != nil {
+			return err
+		}
+	case op.key != "":
+		key, err := s.key(ctx, op.client, op.group, op.key)
+		if err != nil {
+			return err
+		}
+
+		if err := s.client.Del(ctx, key).Err(); err != nil {
+			return err
+		}
+	}
+
+	if len(op.tags) > 0 {
+		tagKeys := make([]string, len(op.tags))
+		for i, tag := range op.tags {
+			tagKeys[i] = redisTagKey(tag)
+		}
+
+		if err := redisPurgeTagsScript.Run(ctx, s.client, tagKeys).Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *redisCacheStore) listTags(ctx context.Context) ([]CacheTag, error) {
+	tags := make([]CacheTag, 0)
+
+	iter := s.client.Scan(ctx, 0, redisTagKeyPrefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		n, err := s.client.SCard(ctx, iter.Val()).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, CacheTag{
+			Name: strings.TrimPrefix(iter.Val(), redisTagKeyPrefix),
+			Keys: int(n),
+		})
+	}
+
+	return tags, iter.Err()
+}
+
+// key returns the cache key for a given key within the current version of a group.
+func (s *redisCacheStore) key(ctx context.Context, client *CacheClient, group, key string) (string, error) {
+	if group == "" {
+		return key, nil
+	}
+
+	version, err := s.client.Get(ctx, redisGroupKey(group)).Uint64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return "", fmt.Errorf("failed to get cache group version: %w", err)
+	}
+
+	return client.groupKey(group, version, key), nil
+}
+
+func (s *redisCacheStore) close() {
+	_ = s.client.Close()
+}
+
+// redisTagKey returns the key of the set used to store the cache keys for a given tag.
+func redisTagKey(tag string) string {
+	return redisTagKeyPrefix + tag
+}
+
+// redisGroupKey returns the key used to store the current version of a given group.
+func redisGroupKey(group string) string {
+	return redisGroupKeyPrefix + group
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type redisCacheTest struct {
+	Value string
+}
+
+func TestRedisCacheStore(t *testing.T) {
+	srv := miniredis.RunT(t)
+	store, err := newRedisCache(&redis.Options{Addr: srv.Addr()})
+	require.NoError(t, err)
+	client := NewCacheClient(store, GobCodec{})
+	defer client.Close()
+
+	// Cache some data
+	data := redisCacheTest{Value: "abcdef"}
+	group := "testgroup"
+	key := "testkey"
+	err = client.
+		Set().
+		Group(group).
+		Key(key).
+		Data(data).
+		Expiration(time.Minute).
+		Save(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, srv.TTL(client.groupKey(group, 0, key)))
+
+	// Get the data
+	var fromCache redisCacheTest
+	err = client.
+		Get().
+		Group(group).
+		Key(key).
+		Fetch(context.Background(), &fromCache)
+	require.NoError(t, err)
+	assert.Equal(t, data, fromCache)
+
+	// The same key with the wrong group should fail
+	err = client.
+		Get().
+		Key(key).
+		Fetch(context.Background(), new(string))
+	assert.Equal(t, ErrCacheMiss, err)
+
+	// Flush the data
+	err = client.
+		Flush().
+		Group(group).
+		Key(key).
+		Execute(context.Background())
+	require.NoError(t, err)
+
+	assertFlushed := func(key string) {
+		err = client.
+			Get().
+			Group(group).
+			Key(key).
+			Fetch(context.Background(), new(string))
+		assert.Equal(t, ErrCacheMiss, err)
+	}
+	assertFlushed(key)
+
+	// Set with tags
+	key = "testkey2"
+	err = client.
+		Set().
+		Group(group).
+		Key(key).
+		Data(data).
+		Tags("tag1", "tag2").
+		Expiration(time.Hour).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	// Check the tag sets
+	gk := client.groupKey(group, 0, key)
+	for _, tag := range []string{"tag1", "tag2"} {
+		members, err := srv.Members(redisTagKey(tag))
+		require.NoError(t, err)
+		assert.Equal(t, []string{gk}, members)
+		assert.Equal(t, time.Hour, srv.TTL(redisTagKey(tag)))
+	}
+
+	// Tag another entry with a shorter expiration which should not shorten the tag set's expiration
+	err = client.
+		Set().
+		Key("testkey3").
+		Data("abc").
+		Tags("tag1").
+		Expiration(time.Minute).
+		Save(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, srv.TTL(redisTagKey("tag1")))
+
+	// List the tags
+	tags, err := client.Tags(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []CacheTag{{Name: "tag1", Keys: 2}, {Name: "tag2", Keys: 1}}, tags)
+
+	// Flush one of tags
+	err = client.
+		Flush().
+		Tags("tag1").
+		Execute(context.Background())
+	require.NoError(t, err)
+
+	// The data should be gone
+	assertFlushed(key)
+	assert.False(t, srv.Exists("testkey3"))
+	assert.False(t, srv.Exists(redisTagKey("tag1")))
+
+	// Flush the entire group
+	err = client.
+		Set().
+		Group(group).
+		Key(key).
+		Data(data).
+		Expiration(time.Hour).
+		Save(context.Background())
+	require.NoError(t, err)
+
+	err = client.
+		Flush().
+		Group(group).
+		All().
+		Execute(context.Background())
+	require.NoError(t, err)
+	assertFlushed(key)
+
+	version, err := srv.Get(redisGroupKey(group))
+	require.NoError(t, err)
+	assert.Equal(t, "1", version)
+
+	// Keys set after the flush belong to the new version of the group
+	err = client.
+		Set().
+		Group(group).
+		Key(key).
+		Data(data).
+		Expiration(time.Hour).
+		Save(context.Background())
+	require.NoError(t, err)
+	assert.True(t, srv.Exists(client.groupKey(group, 1, key)))
+
+	fromCache = redisCacheTest{}
+	err = client.
+		Get().
+		Group(group).
+		Key(key).
+		Fetch(context.Background(), &fromCache)
+	require.NoError(t, err)
+	assert.Equal(t, data, fromCache)
+}
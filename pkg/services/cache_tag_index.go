@@ -0,0 +1,268 @@
+package services
+
+import (
+	"container/list"
+	"hash/maphash"
+	"sync"
+	"sync/atomic"
+)
+
+// tagIndexShards is the amount of shards the tag index is split in to.
+const tagIndexShards = 32
+
+type (
+	// tagIndex maintains an index to support cache tags for in-memory cache stores.
+	// There is a performance and memory impact to using cache tags since set and flush operations using tags require
+	// locking, and we need to keep track of this index in order to keep everything in sync. To limit contention, the
+	// index is split in to shards, each with their own lock, so operations on unrelated keys and tags rarely block
+	// each other.
+	// The index is bounded by the capacity of the cache. If a shard is full when a key is added, the oldest tagged key
+	// in that shard is evicted from the index and, via onEvict, from the cache itself, since a cached entry that is no
+	// longer tracked in the index could not be flushed by its tags.
+	// If using something like Redis for caching, you can leverage sets to store the index.
+	tagIndex struct {
+		shards    [tagIndexShards]*tagIndexShard
+		seed      maphash.Seed
+		capacity  int
+		evictions atomic.Uint64
+
+		// onEvict is called with the keys that were evicted from the index due to capacity.
+		onEvict func(keys ...string)
+	}
+
+	// tagIndexShard holds a portion of the tag index.
+	// Tags and keys are each assigned a shard based on their hash, so the tags of a key are not necessarily held
+	// in the same shard as the key.
+	tagIndexShard struct {
+		sync.Mutex
+		tags     map[string]map[string]struct{} // tag->keys
+		keys     map[string]*list.Element       // key->tagIndexKey
+		order    *list.List                     // tagIndexKeys, oldest first
+		capacity int
+	}
+
+	// tagIndexKey stores the tags of a given key.
+	tagIndexKey struct {
+		key  string
+		tags map[string]struct{}
+	}
+
+	// CacheTagIndexStats contains statistics about a cache tag index.
+	CacheTagIndexStats struct {
+		// Tags is the amount of tags in the index.
+		Tags int
+
+		// Keys is the amount of tagged keys in the index.
+		Keys int
+
+		// Capacity is the maximum amount of tagged keys the index can hold.
+		Capacity int
+
+		// Evictions is the amount of keys that have been evicted because the index was full.
+		Evictions uint64
+	}
+)
+
+// newTagIndex creates a new tagIndex which can hold up to a given amount of keys.
+func newTagIndex(capacity int) *tagIndex {
+	i := &tagIndex{
+		seed:     maphash.MakeSeed(),
+		capacity: capacity,
+	}
+
+	shardCapacity := max(1, (capacity+tagIndexShards-1)/tagIndexShards)
+	for n := range i.shards {
+		i.shards[n] = &tagIndexShard{
+			tags:     make(map[string]map[string]struct{}),
+			keys:     make(map[string]*list.Element),
+			order:    list.New(),
+			capacity: shardCapacity,
+		}
+	}
+
+	return i
+}
+
+// shard returns the shard responsible for a given key or tag.
+func (i *tagIndex) shard(s string) *tagIndexShard {
+	return i.shards[maphash.String(i.seed, s)%tagIndexShards]
+}
+
+func (i *tagIndex) setTags(key string, tags ...string) {
+	// Add the tags to the key.
+	evicted := i.shard(key).addTags(key, tags)
+
+	// Add the key to the tags.
+	for _, tag := range tags {
+		s := i.shard(tag)
+		s.Lock()
+		if _, exists := s.tags[tag]; !exists {
+			s.tags[tag] = make(map[string]struct{})
+		}
+		s.tags[tag][key] = struct{}{}
+		s.Unlock()
+	}
+
+	// Remove any keys that were evicted to make room.
+	if len(evicted) > 0 {
+		i.evictions.Add(uint64(len(evicted)))
+		for _, k := range evicted {
+			i.removeKeyFromTags(k.key, k.tags)
+		}
+
+		if i.onEvict != nil {
+			keys := make([]string, len(evicted))
+			for n, k := range evicted {
+				keys[n] = k.key
+			}
+			i.onEvict(keys...)
+		}
+	}
+}
+
+func (i *tagIndex) purgeTags(tags ...string) []string {
+	keys := make([]string, 0)
+
+	for _, tag := range tags {
+		s := i.shard(tag)
+		s.Lock()
+		tagKeys, exists := s.tags[tag]
+		delete(s.tags, tag)
+		s.Unlock()
+
+		if !exists {
+			continue
+		}
+
+		for key := range tagKeys {
+			i.shard(key).removeTag(key, tag)
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func (i *tagIndex) purgeKeys(keys ...string) {
+	for _, key := range keys {
+		if tags := i.shard(key).removeKey(key); len(tags) > 0 {
+			i.removeKeyFromTags(key, tags)
+		}
+	}
+}
+
+// removeKeyFromTags removes a given key from the given tags.
+func (i *tagIndex) removeKeyFromTags(key string, tags map[string]struct{}) {
+	for tag := range tags {
+		s := i.shard(tag)
+		s.Lock()
+		delete(s.tags[tag], key)
+		if len(s.tags[tag]) == 0 {
+			delete(s.tags, tag)
+		}
+		s.Unlock()
+	}
+}
+
+// clear removes all tags and keys from the index.
+func (i *tagIndex) clear() {
+	for _, s := range i.shards {
+		s.Lock()
+		clear(s.tags)
+		clear(s.keys)
+		s.order.Init()
+		s.Unlock()
+	}
+}
+
+// stats returns statistics about the index.
+func (i *tagIndex) stats() CacheTagIndexStats {
+	st := CacheTagIndexStats{
+		Capacity:  i.capacity,
+		Evictions: i.evictions.Load(),
+	}
+
+	for _, s := range i.shards {
+		s.Lock()
+		st.Tags += len(s.tags)
+		st.Keys += len(s.keys)
+		s.Unlock()
+	}
+
+	return st
+}
+
+// list returns all tags in the index along with the amount of keys for each.
+func (i *tagIndex) list() []CacheTag {
+	tags := make([]CacheTag, 0)
+
+	for _, s := range i.shards {
+		s.Lock()
+		for tag, keys := range s.tags {
+			tags = append(tags, CacheTag{Name: tag, Keys: len(keys)})
+		}
+		s.Unlock()
+	}
+
+	return tags
+}
+
+// addTags adds tags to a given key and returns the keys that were evicted, if any, to make room for it.
+func (s *tagIndexShard) addTags(key string, tags []string) []*tagIndexKey {
+	s.Lock()
+	defer s.Unlock()
+
+	var evicted []*tagIndexKey
+
+	e, exists := s.keys[key]
+	if !exists {
+		for s.order.Len() >= s.capacity {
+			oldest := s.order.Remove(s.order.Front()).(*tagIndexKey)
+			delete(s.keys, oldest.key)
+			evicted = append(evicted, oldest)
+		}
+
+		e = s.order.PushBack(&tagIndexKey{
+			key:  key,
+			tags: make(map[string]struct{}, len(tags)),
+		})
+		s.keys[key] = e
+	}
+
+	k := e.Value.(*tagIndexKey)
+	for _, tag := range tags {
+		k.tags[tag] = struct{}{}
+	}
+
+	return evicted
+}
+
+// removeTag removes a tag from a given key, removing the key entirely if it has no remaining tags.
+func (s *tagIndexShard) removeTag(key, tag string) {
+	s.Lock()
+	defer s.Unlock()
+
+	if e, exists := s.keys[key]; exists {
+		k := e.Value.(*tagIndexKey)
+		delete(k.tags, tag)
+		if len(k.tags) == 0 {
+			s.order.Remove(e)
+			delete(s.keys, key)
+		}
+	}
+}
+
+// removeKey removes a given key and returns its tags.
+func (s *tagIndexShard) removeKey(key string) map[string]struct{} {
+	s.Lock()
+	defer s.Unlock()
+
+	e, exists := s.keys[key]
+	if !exists {
+		return nil
+	}
+
+	s.order.Remove(e)
+	delete(s.keys, key)
+	return e.Value.(*tagIndexKey).tags
+}
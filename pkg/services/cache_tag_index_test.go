@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagIndex(t *testing.T) {
+	index := newTagIndex(100)
+
+	index.setTags("key1", "tag1", "tag2")
+	index.setTags("key2", "tag2")
+	index.setTags("key3", "tag3")
+	assert.Equal(t, CacheTagIndexStats{Tags: 3, Keys: 3, Capacity: 100}, index.stats())
+
+	// Purging a tag should return its keys and remove keys without any remaining tags
+	assert.ElementsMatch(t, []string{"key1", "key2"}, index.purgeTags("tag2"))
+	assert.Equal(t, CacheTagIndexStats{Tags: 2, Keys: 2, Capacity: 100}, index.stats())
+	assert.ElementsMatch(t, []string{"key1"}, index.purgeTags("tag1"))
+
+	// Purging a key should remove it from its tags
+	index.purgeKeys("key3")
+	assert.Empty(t, index.purgeTags("tag3"))
+	assert.Equal(t, CacheTagIndexStats{Capacity: 100}, index.stats())
+}
+
+func TestTagIndex_Eviction(t *testing.T) {
+	index := newTagIndex(tagIndexShards)
+
+	var evicted []string
+	index.onEvict = func(keys ...string) {
+		evicted = append(evicted, keys...)
+	}
+
+	// Each shard holds a single key, so adding more keys than shards must evict
+	for n := range tagIndexShards * 2 {
+		index.setTags(fmt.Sprintf("key%d", n), "tag")
+	}
+
+	stats := index.stats()
+	assert.LessOrEqual(t, stats.Keys, tagIndexShards)
+	assert.Equal(t, uint64(len(evicted)), stats.Evictions)
+	assert.Equal(t, tagIndexShards*2, stats.Keys+len(evicted))
+
+	// Evicted keys should no longer be tracked by their tags
+	assert.Len(t, index.purgeTags("tag"), stats.Keys)
+}
+
+func TestTagIndex_Concurrency(t *testing.T) {
+	index := newTagIndex(10000)
+	var wg sync.WaitGroup
+
+	for n := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := range 100 {
+				key := fmt.Sprintf("key%d-%d", n, k)
+				index.setTags(key, "tag", fmt.Sprintf("tag%d", n))
+				if k%2 == 0 {
+					index.purgeKeys(key)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, 500, index.stats().Keys)
+	assert.Len(t, index.purgeTags("tag"), 500)
+	assert.Equal(t, CacheTagIndexStats{Tags: 10, Keys: 500, Capacity: 10000}, index.stats())
+}
+
+func TestInMemoryCacheStore_TagIndexEviction(t *testing.T) {
+	store, err := newInMemoryCache(tagIndexShards)
+	require.NoError(t, err)
+	client := NewCacheClient(store, GobCodec{})
+	defer client.Close()
+
+	for n := range tagIndexShards * 2 {
+		err = client.
+			Set().
+			Key(fmt.Sprintf("key%d", n)).
+			Data(n).
+			Tags("tag").
+			Expiration(time.Hour).
+			Save(context.Background())
+		require.NoError(t, err)
+	}
+
+	stats, ok := client.TagIndexStats()
+	require.True(t, ok)
+	require.NotZero(t, stats.Evictions)
+
+	// Every entry that is still cached must still be tracked in the tag index
+	err = client.
+		Flush().
+		Tags("tag").
+		Execute(context.Background())
+	require.NoError(t, err)
+
+	for n := range tagIndexShards * 2 {
+		err = client.
+			Get().
+			Key(fmt.Sprintf("key%d", n)).
+			Fetch(context.Background(), new(string))
+		assert.Equal(t, ErrCacheMiss, err)
+	}
+}
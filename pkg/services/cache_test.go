@@ -28,21 +28,20 @@ func TestCacheClient(t *testing.T) {
 	require.NoError(t, err)
 
 	// Get the data
-	fromCache, err := c.Cache.
+	var fromCache cacheTest
+	err = c.Cache.
 		Get().
 		Group(group).
 		Key(key).
-		Fetch(context.Background())
+		Fetch(context.Background(), &fromCache)
 	require.NoError(t, err)
-	cast, ok := fromCache.(cacheTest)
-	require.True(t, ok)
-	assert.Equal(t, data, cast)
+	assert.Equal(t, data, fromCache)
 
 	// The same key with the wrong group should fail
-	_, err = c.Cache.
+	err = c.Cache.
 		Get().
 		Key(key).
-		Fetch(context.Background())
+		Fetch(context.Background(), new(string))
 	assert.Equal(t, ErrCacheMiss, err)
 
 	// Flush the data
@@ -56,11 +55,11 @@ func TestCacheClient(t *testing.T) {
 	// The data should be gone
 	assertFlushed := func(key string) {
 		// The data should be gone
-		_, err = c.Cache.
+		err = c.Cache.
 			Get().
 			Group(group).
 			Key(key).
-			Fetch(context.Background())
+			Fetch(context.Background(), new(string))
 		assert.Equal(t, ErrCacheMiss, err)
 	}
 	assertFlushed(key)
@@ -79,15 +78,14 @@ func TestCacheClient(t *testing.T) {
 
 	// Check the tag index
 	index := c.Cache.store.(*inMemoryCacheStore).tagIndex
-	gk := c.Cache.cacheKey(group, key)
-	_, exists := index.tags["tag1"][gk]
-	assert.True(t, exists)
-	_, exists = index.tags["tag2"][gk]
-	assert.True(t, exists)
-	_, exists = index.keys[gk]["tag1"]
+	gk := c.Cache.groupKey(group, 0, key)
+	_, exists := index.shard("tag1").tags["tag1"][gk]
 	assert.True(t, exists)
-	_, exists = index.keys[gk]["tag2"]
+	_, exists = index.shard("tag2").tags["tag2"][gk]
 	assert.True(t, exists)
+	e, exists := index.shard(gk).keys[gk]
+	require.True(t, exists)
+	assert.Len(t, e.Value.(*tagIndexKey).tags, 2)
 
 	// Flush one of tags
 	err = c.Cache.
@@ -100,6 +98,92 @@ func TestCacheClient(t *testing.T) {
 	assertFlushed(key)
 
 	// The index should be empty
-	assert.Empty(t, index.tags)
-	assert.Empty(t, index.keys)
+	stats, ok := c.Cache.TagIndexStats()
+	require.True(t, ok)
+	assert.Zero(t, stats.Tags)
+	assert.Zero(t, stats.Keys)
+}
+
+func TestCacheClient_FlushGroup(t *testing.T) {
+	store, err := newInMemoryCache(100)
+	require.NoError(t, err)
+	client := NewCacheClient(store, GobCodec{})
+	defer client.Close()
+
+	set := func(group, key string, tags ...string) {
+		err := client.
+			Set().
+			Group(group).
+			Key(key).
+			Data(key).
+			Tags(tags...).
+			Expiration(time.Hour).
+			Save(context.Background())
+		require.NoError(t, err)
+	}
+
+	get := func(group, key string) error {
+		err := client.
+			Get().
+			Group(group).
+			Key(key).
+			Fetch(context.Background(), new(string))
+		return err
+	}
+
+	set("group1", "key1", "tag1")
+	set("group1", "key2")
+	set("group2", "key1")
+	set("", "key1")
+
+	// Groups nested within the name of another and keys which look like a version of the group should not be
+	// mistaken for keys of the group.
+	set("group1::nested", "key1")
+	set("group1", "v1::key3")
+
+	// A group is required.
+	err = client.
+		Flush().
+		All().
+		Execute(context.Background())
+	assert.Error(t, err)
+
+	// Flush the entire group.
+	err = client.
+		Flush().
+		Group("group1").
+		All().
+		Execute(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, ErrCacheMiss, get("group1", "key1"))
+	assert.Equal(t, ErrCacheMiss, get("group1", "key2"))
+	assert.NoError(t, get("group2", "key1"))
+	assert.NoError(t, get("", "key1"))
+	assert.NoError(t, get("group1::nested", "key1"))
+	assert.Equal(t, ErrCacheMiss, get("group1", "key3"))
+	assert.Equal(t, ErrCacheMiss, get("group1", "v1::key3"))
+
+	// The old entries should be removed from the store and the tag index.
+	_, exists := store.(*inMemoryCacheStore).store.Get(client.groupKey("group1", 0, "key1"))
+	assert.False(t, exists)
+	_, exists = store.(*inMemoryCacheStore).store.Get(client.groupKey("group1", 0, "v1::key3"))
+	assert.False(t, exists)
+	stats, ok := client.TagIndexStats()
+	require.True(t, ok)
+	assert.Zero(t, stats.Keys)
+
+	// Keys set after the flush belong to the new version of the group.
+	set("group1", "key1", "tag1")
+	assert.NoError(t, get("group1", "key1"))
+	_, exists = store.(*inMemoryCacheStore).store.Get(client.groupKey("group1", 1, "key1"))
+	assert.True(t, exists)
+
+	// Tags still apply to the new version.
+	err = client.
+		Flush().
+		Tags("tag1").
+		Execute(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, ErrCacheMiss, get("group1", "key1"))
 }
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/log"
+)
+
+type (
+	// tieredCacheStore is a cache store implementation which places a local, in-memory L1 store with a short
+	// expiration in front of a shared L2 store, such as Redis, which is used by every instance of the application.
+	// Flushes are applied to both stores and broadcast to all other instances so their L1 stores stay coherent.
+	// Sets are not broadcast, so other instances may continue to serve a previous value from their L1 store until it
+	// expires. Since entries read from the L2 store are cached locally without their tags, flushing tags clears the
+	// entire L1 store of every instance.
+	tieredCacheStore struct {
+		l1          *inMemoryCacheStore
+		l2          CacheStore
+		expiration  time.Duration
+		broadcaster cacheBroadcaster
+
+		// origin identifies this store in broadcast messages so it can ignore its own flushes.
+		origin string
+	}
+
+	// cacheBroadcaster broadcasts cache flushes to other instances of the application.
+	cacheBroadcaster interface {
+		// publish sends a flush message to all subscribers
+		publish(context.Context, cacheFlushMessage) error
+
+		// subscribe registers a callback to be executed for each message received
+		subscribe(func(cacheFlushMessage))
+
+		// close stops the broadcaster
+		close()
+	}
+
+	// cacheFlushMessage is a flush that is broadcast between instances of the application.
+	cacheFlushMessage struct {
+		Origin string `json:"origin,omitempty"`
+		Group  string `json:"group,omitempty"`
+		Key    string `json:"key,omitempty"`
+		All    bool   `json:"all,omitempty"`
+
+		// Clear indicates that the entire L1 store must be cleared.
+		Clear bool `json:"clear,omitempty"`
+	}
+)
+
+// newTieredCache creates a new tiered CacheStore with an L1 store which can hold up to a given amount of entries,
+// for a given duration, in front of a given L2 store.
+func newTieredCache(
+	capacity int,
+	expiration time.Duration,
+	l2 CacheStore,
+	broadcaster cacheBroadcaster,
+) (CacheStore, error) {
+	l1, err := newInMemoryCache(capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	origin := make([]byte, 16)
+	if _, err = rand.Read(origin); err != nil {
+		return nil, err
+	}
+
+	s := &tieredCacheStore{
+		l1:          l1.(*inMemoryCacheStore),
+		l2:          l2,
+		expiration:  expiration,
+		broadcaster: broadcaster,
+		origin:      hex.EncodeToString(origin),
+	}
+
+	broadcaster.subscribe(func(msg cacheFlushMessage) {
+		if msg.Origin != s.origin {
+			s.flushL1(msg)
+		}
+	})
+
+	return s, nil
+}
+
+func (s *tieredCacheStore) get(ctx context.Context, op *CacheGetOp) ([]byte, error) {
+	data, err := s.l1.get(ctx, op)
+	if err == nil {
+		return data, nil
+	}
+
+	data, err = s.l2.get(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cache the entry locally, without any tags, since they're not known.
+	_ = s.l1.set(ctx, &CacheSetOp{
+		client:     op.client,
+		group:      op.group,
+		key:        op.key,
+		expiration: s.expiration,
+	}, data)
+
+	return data, nil
+}
+
+func (s *tieredCacheStore) set(ctx context.Context, op *CacheSetOp, data []byte) error {
+	if err := s.l2.set(ctx, op, data); err != nil {
+		return err
+	}
+
+	return s.l1.set(ctx, &CacheSetOp{
+		client:     op.client,
+		group:      op.group,
+		key:        op.key,
+		expiration: min(op.expiration, s.expiration),
+	}, data)
+}
+
+func (s *tieredCacheStore) flush(ctx context.Context, op *CacheFlushOp) error {
+	if err := s.l2.flush(ctx, op); err != nil {
+		return err
+	}
+
+	msg := cacheFlushMessage{
+		Origin: s.origin,
+		Group:  op.group,
+		Key:    op.key,
+		All:    op.all,
+		Clear:  len(op.tags) > 0,
+	}
+
+	s.flushL1(msg)
+
+	if err := s.broadcaster.publish(ctx, msg); err != nil {
+		return fmt.Errorf("failed to broadcast cache flush: %w", err)
+	}
+
+	return nil
+}
+
+// flushL1 applies a given flush to the L1 store.
+func (s *tieredCacheStore) flushL1(msg cacheFlushMessage) {
+	if msg.Clear {
+		s.l1.clear()
+		return
+	}
+
+	// The client is only used to format the cache keys.
+	err := s.l1.flush(context.Background(), &CacheFlushOp{
+		client: new(CacheClient),
+		group:  msg.Group,
+		key:    msg.Key,
+		all:    msg.All && msg.Group != "",
+	})
+
+	if err != nil {
+		log.Default().Error("failed to flush L1 cache",
+			"error", err,
+		)
+	}
+}
+
+func (s *tieredCacheStore) listTags(ctx context.Context) ([]CacheTag, error) {
+	if l, ok := s.l2.(cacheTagLister); ok {
+		return l.listTags(ctx)
+	}
+	return nil, errors.New("cache store does not support listing tags")
+}
+
+func (s *tieredCacheStore) close() {
+	s.broadcaster.close()
+	s.l1.close()
+	s.l2.close()
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// localCacheBroadcaster is an in-process cacheBroadcaster for tests.
+type localCacheBroadcaster struct {
+	mu          sync.Mutex
+	subscribers []func(cacheFlushMessage)
+}
+
+func (b *localCacheBroadcaster) publish(_ context.Context, msg cacheFlushMessage) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, fn := range b.subscribers {
+		fn(msg)
+	}
+	return nil
+}
+
+func (b *localCacheBroadcaster) subscribe(fn func(cacheFlushMessage)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers = append(b.subscribers, fn)
+}
+
+func (b *localCacheBroadcaster) close() {}
+
+func TestTieredCacheStore(t *testing.T) {
+	l2, err := newInMemoryCache(100)
+	require.NoError(t, err)
+	broadcaster := new(localCacheBroadcaster)
+
+	// Create two instances which share the same L2 store
+	newClient := func() (*CacheClient, *tieredCacheStore) {
+		store, err := newTieredCache(100, time.Minute, l2, broadcaster)
+		require.NoError(t, err)
+		return NewCacheClient(store, GobCodec{}), store.(*tieredCacheStore)
+	}
+	client1, store1 := newClient()
+	client2, store2 := newClient()
+	defer client1.Close()
+
+	set := func(client *CacheClient, group, key, value string, tags ...string) {
+		err := client.
+			Set().
+			Group(group).
+			Key(key).
+			Data(value).
+			Tags(tags...).
+			Expiration(time.Hour).
+			Save(context.Background())
+		require.NoError(t, err)
+	}
+
+	get := func(client *CacheClient, group, key string) (string, error) {
+		var v string
+		err := client.
+			Get().
+			Group(group).
+			Key(key).
+			Fetch(context.Background(), &v)
+		return v, err
+	}
+
+	inL1 := func(store *tieredCacheStore, group, key string) bool {
+		_, err := store.l1.get(context.Background(), &CacheGetOp{
+			client: client1,
+			group:  group,
+			key:    key,
+		})
+		return err == nil
+	}
+
+	// Entries set in one instance are available to the other via L2
+	set(client1, "group", "key1", "abc", "tag")
+	assert.True(t, inL1(store1, "group", "key1"))
+	assert.False(t, inL1(store2, "group", "key1"))
+	v, err := get(client2, "group", "key1")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", v)
+	assert.True(t, inL1(store2, "group", "key1"))
+
+	// Flushing a key removes it from every L1
+	err = client1.
+		Flush().
+		Group("group").
+		Key("key1").
+		Execute(context.Background())
+	require.NoError(t, err)
+	assert.False(t, inL1(store1, "group", "key1"))
+	assert.False(t, inL1(store2, "group", "key1"))
+	_, err = get(client2, "group", "key1")
+	assert.Equal(t, ErrCacheMiss, err)
+
+	// Flushing a group removes its keys from every L1
+	set(client1, "group", "key1", "abc")
+	_, err = get(client2, "group", "key1")
+	require.NoError(t, err)
+	err = client2.
+		Flush().
+		Group("group").
+		All().
+		Execute(context.Background())
+	require.NoError(t, err)
+	assert.False(t, inL1(store1, "group", "key1"))
+	assert.False(t, inL1(store2, "group", "key1"))
+	_, err = get(client1, "group", "key1")
+	assert.Equal(t, ErrCacheMiss, err)
+
+	// Flushing tags clears every L1
+	set(client1, "group", "key1", "abc", "tag")
+	set(client1, "", "key2", "def")
+	_, err = get(client2, "group", "key1")
+	require.NoError(t, err)
+	_, err = get(client2, "", "key2")
+	require.NoError(t, err)
+	err = client1.
+		Flush().
+		Tags("tag").
+		Execute(context.Background())
+	require.NoError(t, err)
+	assert.False(t, inL1(store2, "group", "key1"))
+	assert.False(t, inL1(store2, "", "key2"))
+	_, err = get(client2, "group", "key1")
+	assert.Equal(t, ErrCacheMiss, err)
+	v, err = get(client2, "", "key2")
+	require.NoError(t, err)
+	assert.Equal(t, "def", v)
+}
+
+func TestPostgresCacheBroadcaster(t *testing.T) {
+	b1 := newPostgresCacheBroadcaster(c.Database)
+	b2 := newPostgresCacheBroadcaster(c.Database)
+	defer b1.close()
+	defer b2.close()
+
+	received := make(chan cacheFlushMessage, 10)
+	b1.subscribe(func(cacheFlushMessage) {})
+	b2.subscribe(func(msg cacheFlushMessage) {
+		received <- msg
+	})
+
+	// A clear message is sent once listening
+	select {
+	case msg := <-received:
+		assert.True(t, msg.Clear)
+	case <-time.After(5 * time.Second):
+		t.Fatal("listener did not connect")
+	}
+
+	msg := cacheFlushMessage{
+		Origin: "abc",
+		Group:  "group",
+		Key:    "key",
+	}
+	err := b1.publish(context.Background(), msg)
+	require.NoError(t, err)
+
+	select {
+	case got := <-received:
+		assert.Equal(t, msg, got)
+	case <-time.After(5 * time.Second):
+		t.Fatal("message not received")
+	}
+}
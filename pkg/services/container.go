@@ -45,6 +45,9 @@ type Container struct {
 	// Cache contains the cache client.
 	Cache *CacheClient
 
+	// RateLimiter stores a rate limiter which is backed by the cache.
+	RateLimiter *RateLimiter
+
 	// Database stores the connection to the database.
 	Database *sql.DB
 
@@ -63,6 +66,9 @@ type Container struct {
 	// Auth stores an authentication client.
 	Auth *AuthClient
 
+	// Session stores the session store, which is backed by the database.
+	Session *SessionStore
+
 	// River stores the River client for task queueing.
 	River *river.Client
 }
@@ -73,11 +79,13 @@ func NewContainer() *Container {
 	c.initConfig()
 	c.initValidator()
 	c.initWeb()
-	c.initCache()
 	c.initDatabase()
+	c.initCache()
+	c.initRateLimiter()
 	c.initFiles()
 	c.initORM()
 	c.initAuth()
+	c.initSession()
 	c.initMail()
 	// c.initTasks() // Removed backlite
 	c.initRiver()
@@ -110,6 +118,9 @@ func (c *Container) Shutdown() error {
 		}
 	}
 
+	// Shutdown the cache.
+	c.Cache.Close()
+
 	// Shutdown the ORM.
 	if err := c.ORM.Close(); err != nil {
 		return err
@@ -120,9 +131,6 @@ func (c *Container) Shutdown() error {
 		return err
 	}
 
-	// Shutdown the cache.
-	c.Cache.Close()
-
 	return nil
 }
 
@@ -146,6 +154,15 @@ func (c *Container) initConfig() {
 // initValidator initializes the validator.
 func (c *Container) initValidator() {
 	c.Validator = NewValidator()
+
+	policy, err := NewPasswordPolicy(c.Config)
+	if err != nil {
+		panic(fmt.Sprintf("failed to load password policy: %v", err))
+	}
+
+	if err := c.Validator.RegisterPasswordPolicy(policy); err != nil {
+		panic(fmt.Sprintf("failed to register password policy: %v", err))
+	}
 }
 
 // initWeb initializes the web framework.
@@ -157,12 +174,47 @@ func (c *Container) initWeb() {
 
 // initCache initializes the cache.
 func (c *Container) initCache() {
-	store, err := newInMemoryCache(c.Config.Cache.Capacity)
+	var store CacheStore
+	var err error
+
+	switch c.Config.Cache.Store {
+	case config.CacheStoreRedis:
+		store, err = newRedisCache(redisOptions(c.Config))
+	case config.CacheStoreDatabase:
+		store, err = newDatabaseCache(c.Database, c.Config.Cache.Database.SweepInterval)
+	default:
+		store, err = newInMemoryCache(c.Config.Cache.Capacity)
+	}
+
+	if err == nil && c.Config.Cache.L1.Enabled {
+		store, err = newTieredCache(
+			c.Config.Cache.L1.Capacity,
+			c.Config.Cache.L1.Expiration,
+			store,
+			newPostgresCacheBroadcaster(c.Database),
+		)
+	}
+
 	if err != nil {
-		panic(err)
+		panic(fmt.Sprintf("failed to create cache store: %v", err))
+	}
+
+	var codec CacheCodec
+	switch c.Config.Cache.Codec {
+	case config.CacheCodecJSON:
+		codec = JSONCodec{}
+	case config.CacheCodecMsgpack:
+		codec = MsgpackCodec{}
+	default:
+		codec = GobCodec{}
 	}
 
-	c.Cache = NewCacheClient(store)
+	c.Cache = NewCacheClient(store, codec)
+}
+
+// initRateLimiter initializes the rate limiter.
+func (c *Container) initRateLimiter() {
+	c.RateLimiter = NewRateLimiter(c.Cache)
 }
 
 // initDatabase initializes the database.
@@ -237,7 +289,16 @@ func (c *Container) initORM() {
 
 // initAuth initializes the authentication client.
 func (c *Container) initAuth() {
-	c.Auth = NewAuthClient(c.Config, c.ORM)
+	var err error
+	c.Auth, err = NewAuthClient(c.Config, c.ORM)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create auth client: %v", err))
+	}
+}
+
+// initSession initializes the session store.
+func (c *Container) initSession() {
+	c.Session = NewSessionStore(c.Config, c.ORM, c.Web)
 }
 
 // initMail initialize the mail client.
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mikestefanello/pagoda/config"
+)
+
+const (
+	// passwordMaxLength stores the maximum length of passwords in bytes, which is the most that bcrypt can hash
+	passwordMaxLength = 72
+
+	// passwordHashPrefixLength stores the length of the prefix of password hashes that breached passwords are
+	// grouped by
+	passwordHashPrefixLength = 5
+
+	// personalInfoMinLength stores the minimum length of a piece of personal information that passwords cannot contain
+	personalInfoMinLength = 3
+)
+
+// PasswordPolicy enforces the password policy in configuration, which includes rejecting breached passwords.
+type PasswordPolicy struct {
+	config *config.Config
+
+	// breached stores the suffixes of the SHA-1 hashes of breached passwords, keyed by the prefix of the hash, in
+	// the same manner as the k-anonymity model of the Pwned Passwords API, so a different source of ranges could be
+	// used instead of a local file.
+	breached map[string][]string
+}
+
+// NewPasswordPolicy creates a new PasswordPolicy, loading the list of breached passwords, if one is configured.
+func NewPasswordPolicy(cfg *config.Config) (*PasswordPolicy, error) {
+	p := &PasswordPolicy{
+		config:   cfg,
+		breached: make(map[string][]string),
+	}
+
+	if cfg.App.PasswordPolicy.BreachedList == "" {
+		return p, nil
+	}
+
+	f, err := os.Open(cfg.App.PasswordPolicy.BreachedList)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		hash, _, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if len(hash) != sha1.Size*2 {
+			continue
+		}
+		hash = strings.ToUpper(hash)
+		prefix := hash[:passwordHashPrefixLength]
+		p.breached[prefix] = append(p.breached[prefix], hash[passwordHashPrefixLength:])
+	}
+
+	return p, scanner.Err()
+}
+
+// Check determines if a given password meets the length and character class requirements of the policy.
+func (p *PasswordPolicy) Check(password string) bool {
+	cfg := p.config.App.PasswordPolicy
+	if utf8.RuneCountInString(password) < cfg.MinLength || len(password) > passwordMaxLength {
+		return false
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+
+	return (upper || !cfg.RequireUpper) &&
+		(lower || !cfg.RequireLower) &&
+		(digit || !cfg.RequireDigit) &&
+		(symbol || !cfg.RequireSymbol)
+}
+
+// ContainsPersonalInfo determines if a given password contains any of the given personal information of the user,
+// such as their name or email address. Email addresses are checked by their local part, and other information by
+// each of its words, ignoring those which are too short to matter.
+func (p *PasswordPolicy) ContainsPersonalInfo(password string, info ...string) bool {
+	password = strings.ToLower(password)
+
+	for _, v := range info {
+		if local, _, ok := strings.Cut(v, "@"); ok {
+			v = local
+		}
+
+		for _, word := range strings.Fields(strings.ToLower(v)) {
+			if utf8.RuneCountInString(word) >= personalInfoMinLength && strings.Contains(password, word) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// IsBreached determines if a given password is in the list of breached passwords. Only the prefix of the hash of the
+// password is used to look up the range of breached hashes which could match it.
+func (p *PasswordPolicy) IsBreached(password string) bool {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	for _, suffix := range p.breached[hash[:passwordHashPrefixLength]] {
+		if suffix == hash[passwordHashPrefixLength:] {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mikestefanello/pagoda/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordPolicy_Check(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.App.PasswordPolicy.MinLength = 8
+	p, err := NewPasswordPolicy(cfg)
+	require.NoError(t, err)
+
+	assert.False(t, p.Check("short"))
+	assert.True(t, p.Check("longenough"))
+	assert.True(t, p.Check("ünïcödé!"))
+
+	cfg.App.PasswordPolicy.RequireUpper = true
+	cfg.App.PasswordPolicy.RequireLower = true
+	cfg.App.PasswordPolicy.RequireDigit = true
+	cfg.App.PasswordPolicy.RequireSymbol = true
+	assert.False(t, p.Check("longenough"))
+	assert.False(t, p.Check("Longenough1"))
+	assert.True(t, p.Check("Longenough1!"))
+
+	// Passwords cannot be longer than bcrypt can hash
+	long := strings.Repeat("Aa1!", passwordMaxLength/4)
+	assert.True(t, p.Check(long))
+	assert.False(t, p.Check(long+"a"))
+}
+
+func TestPasswordPolicy_ContainsPersonalInfo(t *testing.T) {
+	p, err := NewPasswordPolicy(&config.Config{})
+	require.NoError(t, err)
+
+	assert.True(t, p.ContainsPersonalInfo("my-JOHNSMITH-password", "johnsmith@localhost.localhost"))
+	assert.True(t, p.ContainsPersonalInfo("smith1234", "John Smith"))
+	assert.False(t, p.ContainsPersonalInfo("localhost", "johnsmith@localhost.localhost"))
+	assert.False(t, p.ContainsPersonalInfo("abcdefgh", "Jo Ab"))
+	assert.False(t, p.ContainsPersonalInfo("abcdefgh"))
+}
+
+func TestPasswordPolicy_IsBreached(t *testing.T) {
+	// SHA-1 hashes of "password" and "123456"
+	list := "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:9545824\n" +
+		"7c4a8d09ca3762af61e59520943dc26494f8941b\n" +
+		"invalid\n"
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(path, []byte(list), 0600))
+
+	cfg := &config.Config{}
+	cfg.App.PasswordPolicy.BreachedList = path
+	p, err := NewPasswordPolicy(cfg)
+	require.NoError(t, err)
+
+	assert.True(t, p.IsBreached("password"))
+	assert.True(t, p.IsBreached("123456"))
+	assert.False(t, p.IsBreached("correct horse battery staple"))
+
+	cfg.App.PasswordPolicy.BreachedList = filepath.Join(t.TempDir(), "missing.txt")
+	_, err = NewPasswordPolicy(cfg)
+	assert.Error(t, err)
+}
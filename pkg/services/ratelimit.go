@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"hash/maphash"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// rateLimitCacheGroup is the cache group that rate limit buckets are stored in.
+	rateLimitCacheGroup = "ratelimit"
+
+	// rateLimiterShards is the amount of locks used to serialize updates to buckets.
+	rateLimiterShards = 32
+)
+
+type (
+	// RateLimiter limits how often an action can be performed using token buckets stored in the cache.
+	// Each key has a bucket which holds up to limit tokens and is refilled at a constant rate so that it is full
+	// again after the window has passed. Each action consumes a token, and is denied if the bucket is empty.
+	// Updates to a bucket are serialized within the application instance. When using a store that is shared between
+	// instances, concurrent requests to different instances may occasionally exceed the limit slightly.
+	RateLimiter struct {
+		cache *CacheClient
+		seed  maphash.Seed
+		locks [rateLimiterShards]sync.Mutex
+	}
+
+	// RateLimitResult contains the result of attempting an action against a rate limit.
+	RateLimitResult struct {
+		// Allowed indicates if the action is allowed.
+		Allowed bool
+
+		// Remaining is the amount of actions that are currently allowed.
+		Remaining int
+
+		// RetryAfter is how long until another action will be allowed, if the action was not allowed.
+		RetryAfter time.Duration
+	}
+
+	// rateLimitBucket is what is stored in the cache to track the tokens for a given key.
+	rateLimitBucket struct {
+		Tokens  float64
+		Updated time.Time
+	}
+)
+
+// NewRateLimiter creates a new RateLimiter which stores buckets in a given cache.
+func NewRateLimiter(cache *CacheClient) *RateLimiter {
+	return &RateLimiter{
+		cache: cache,
+		seed:  maphash.MakeSeed(),
+	}
+}
+
+// Allow attempts to perform an action for a given key, allowing up to limit actions within a given window.
+func (r *RateLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (RateLimitResult, error) {
+	switch {
+	case key == "":
+		return RateLimitResult{}, errors.New("no rate limit key specified")
+	case limit <= 0 || window <= 0:
+		return RateLimitResult{}, errors.New("invalid rate limit")
+	}
+
+	lock := &r.locks[maphash.String(r.seed, key)%rateLimiterShards]
+	lock.Lock()
+	defer lock.Unlock()
+
+	now := time.Now()
+	rate := float64(limit) / window.Seconds()
+
+	var bucket rateLimitBucket
+	err := r.cache.
+		Get().
+		Group(rateLimitCacheGroup).
+		Key(key).
+		Fetch(ctx, &bucket)
+
+	switch {
+	case errors.Is(err, ErrCacheMiss):
+		bucket = rateLimitBucket{Tokens: float64(limit)}
+	case err != nil:
+		return RateLimitResult{}, err
+	default:
+		// Refill the bucket based on the time that has passed.
+		elapsed := now.Sub(bucket.Updated).Seconds()
+		bucket.Tokens = math.Min(float64(limit), bucket.Tokens+elapsed*rate)
+	}
+
+	var result RateLimitResult
+	if bucket.Tokens >= 1 {
+		bucket.Tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = time.Duration((1 - bucket.Tokens) / rate * float64(time.Second))
+	}
+	result.Remaining = int(bucket.Tokens)
+	bucket.Updated = now
+
+	// Once the window has passed, the bucket will be full, so it's no longer needed.
+	err = r.cache.
+		Set().
+		Group(rateLimitCacheGroup).
+		Key(key).
+		Data(bucket).
+		Expiration(window).
+		Save(ctx)
+
+	return result, err
+}
+
+// Reset removes the bucket for a given key, allowing the full limit of actions again.
+func (r *RateLimiter) Reset(ctx context.Context, key string) error {
+	return r.cache.
+		Flush().
+		Group(rateLimitCacheGroup).
+		Key(key).
+		Execute(ctx)
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	key := "ratelimit-test"
+	window := 200 * time.Millisecond
+
+	// The limit is allowed
+	for i := 2; i >= 0; i-- {
+		res, err := c.RateLimiter.Allow(ctx, key, 3, window)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, i, res.Remaining)
+		assert.Zero(t, res.RetryAfter)
+	}
+
+	// Exceeding the limit is denied
+	res, err := c.RateLimiter.Allow(ctx, key, 3, window)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Zero(t, res.Remaining)
+	assert.Positive(t, res.RetryAfter)
+	assert.LessOrEqual(t, res.RetryAfter, window/3)
+	retryAfter := res.RetryAfter
+
+	// Other keys are not affected
+	res, err = c.RateLimiter.Allow(ctx, key+"-other", 3, window)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	// Tokens are refilled over time
+	time.Sleep(retryAfter + 10*time.Millisecond)
+	res, err = c.RateLimiter.Allow(ctx, key, 3, window)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	// Resetting restores the full limit
+	require.NoError(t, c.RateLimiter.Reset(ctx, key))
+	res, err = c.RateLimiter.Allow(ctx, key, 3, window)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 2, res.Remaining)
+
+	// Invalid limits fail
+	_, err = c.RateLimiter.Allow(ctx, key, 0, window)
+	assert.Error(t, err)
+	_, err = c.RateLimiter.Allow(ctx, "", 3, window)
+	assert.Error(t, err)
+}
@@ -0,0 +1,234 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/securecookie"
+	"github.com/gorilla/sessions"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent"
+	entsession "github.com/mikestefanello/pagoda/ent/session"
+)
+
+// SessionStore is a sessions.Store which stores session data in the database, as Session entities, rather than in
+// cookies. The cookie only contains a signed, random session ID and only a hash of that ID is stored, exactly like
+// password tokens. Since the data lives on the server, sessions can be listed and revoked, which immediately ends
+// them, even if the cookie was stolen.
+type SessionStore struct {
+	// Options are the default options of new sessions.
+	Options *sessions.Options
+
+	config *config.Config
+	orm    *ent.Client
+	web    *echo.Echo
+	codecs []securecookie.Codec
+}
+
+// NewSessionStore creates a new SessionStore.
+func NewSessionStore(cfg *config.Config, orm *ent.Client, web *echo.Echo) *SessionStore {
+	s := &SessionStore{
+		Options: &sessions.Options{
+			Path:     "/",
+			MaxAge:   int(cfg.App.Session.Expiration.Seconds()),
+			HttpOnly: true,
+			SameSite: http.SameSiteStrictMode,
+		},
+		config: cfg,
+		orm:    orm,
+		web:    web,
+		codecs: securecookie.CodecsFromPairs([]byte(cfg.App.EncryptionKey)),
+	}
+
+	for _, codec := range s.codecs {
+		if sc, ok := codec.(*securecookie.SecureCookie); ok {
+			sc.MaxAge(s.Options.MaxAge)
+			// Session data is stored in the database, so it is not limited to the size of a cookie.
+			sc.MaxLength(0)
+		}
+	}
+
+	return s
+}
+
+// Get returns a session for the given name after adding it to the registry.
+func (s *SessionStore) Get(r *http.Request, name string) (*sessions.Session, error) {
+	return sessions.GetRegistry(r).Get(s, name)
+}
+
+// New returns a session for the given name without adding it to the registry. If the request does not contain a
+// valid session ID, or the session has expired or has been revoked, a new, empty session is returned.
+func (s *SessionStore) New(r *http.Request, name string) (*sessions.Session, error) {
+	sess := sessions.NewSession(s, name)
+	opts := *s.Options
+	sess.Options = &opts
+	sess.IsNew = true
+
+	// Cookies which cannot be decoded, such as those issued by a previous store, are treated as missing rather than
+	// failing the request.
+	c, err := r.Cookie(name)
+	if err != nil {
+		return sess, nil
+	}
+	if err = securecookie.DecodeMulti(name, c.Value, &sess.ID, s.codecs...); err != nil {
+		sess.ID = ""
+		return sess, nil
+	}
+
+	ctx := sessionContext(r)
+	entity, err := s.orm.Session.
+		Query().
+		Where(
+			entsession.Token(hashSessionID(sess.ID)),
+			entsession.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+
+	switch {
+	case ent.IsNotFound(err):
+		sess.ID = ""
+		return sess, nil
+	case err != nil:
+		return sess, err
+	}
+
+	if err = securecookie.DecodeMulti(name, entity.Data, &sess.Values, s.codecs...); err != nil {
+		return sess, err
+	}
+	sess.IsNew = false
+
+	// Periodically record that the session is still in use.
+	if time.Since(entity.LastSeenAt) > s.config.App.Session.TouchInterval {
+		err = entity.Update().
+			SetLastSeenAt(time.Now()).
+			SetIP(s.ip(r)).
+			SetUserAgent(r.UserAgent()).
+			Exec(ctx)
+	}
+
+	return sess, err
+}
+
+// Save stores a given session in the database and adds the session ID cookie to the response.
+// If the Options.MaxAge of the session is <= 0, the session is deleted from the database and the cookie is removed.
+// Sessions which have been revoked since they were loaded are not stored again; their values are cleared instead.
+func (s *SessionStore) Save(r *http.Request, w http.ResponseWriter, sess *sessions.Session) error {
+	ctx := sessionContext(r)
+
+	if sess.Options.MaxAge <= 0 {
+		if sess.ID != "" {
+			_, err := s.orm.Session.
+				Delete().
+				Where(entsession.Token(hashSessionID(sess.ID))).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		http.SetCookie(w, sessions.NewCookie(sess.Name(), "", sess.Options))
+		return nil
+	}
+
+	data, err := securecookie.EncodeMulti(sess.Name(), sess.Values, s.codecs...)
+	if err != nil {
+		return err
+	}
+
+	// Associate the session with the user it authenticates so it can be listed and revoked.
+	userID, authenticated := sessionUserID(sess.Values)
+	expiresAt := time.Now().Add(time.Duration(sess.Options.MaxAge) * time.Second)
+
+	var entity *ent.Session
+	if sess.ID != "" {
+		entity, err = s.orm.Session.
+			Query().
+			Where(entsession.Token(hashSessionID(sess.ID))).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+	}
+
+	if entity != nil {
+		op := entity.Update().
+			SetData(data).
+			SetLastSeenAt(time.Now()).
+			SetExpiresAt(expiresAt)
+
+		if authenticated {
+			op.SetUserID(userID)
+		} else {
+			op.ClearUserID()
+		}
+
+		if err = op.Exec(ctx); err != nil {
+			return err
+		}
+	} else if sess.ID != "" {
+		// The session was revoked after it was loaded, so it is not brought back, and the cookie is removed instead.
+		sess.ID = ""
+		sess.Values = make(map[any]any)
+		opts := *sess.Options
+		opts.MaxAge = -1
+		http.SetCookie(w, sessions.NewCookie(sess.Name(), "", &opts))
+		return nil
+	} else {
+		// Sessions are only created here, so this is when expired ones are removed.
+		_, err = s.orm.Session.
+			Delete().
+			Where(entsession.ExpiresAtLTE(time.Now())).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		sess.ID = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(securecookie.GenerateRandomKey(32))
+		op := s.orm.Session.
+			Create().
+			SetToken(hashSessionID(sess.ID)).
+			SetName(sess.Name()).
+			SetData(data).
+			SetIP(s.ip(r)).
+			SetUserAgent(r.UserAgent()).
+			SetExpiresAt(expiresAt)
+
+		if authenticated {
+			op.SetUserID(userID)
+		}
+
+		if err = op.Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	encoded, err := securecookie.EncodeMulti(sess.Name(), sess.ID, s.codecs...)
+	if err != nil {
+		return err
+	}
+	http.SetCookie(w, sessions.NewCookie(sess.Name(), encoded, sess.Options))
+	return nil
+}
+
+// sessionContext returns the context used to load and save the sessions of a given request. Sessions are still
+// needed after the request has been canceled, such as by the timeout middleware, since the error page renders flash
+// messages.
+func sessionContext(r *http.Request) context.Context {
+	return context.WithoutCancel(r.Context())
+}
+
+// ip returns the IP address of the client of a given request, the same way Echo does.
+func (s *SessionStore) ip(r *http.Request) string {
+	return s.web.NewContext(r, nil).RealIP()
+}
+
+// hashSessionID hashes a given session ID so that IDs are not stored in the database. Unlike passwords, session IDs
+// are random and long enough that a fast hash is sufficient, which allows them to be looked up.
+func hashSessionID(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/sessions"
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/session"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionStore(t *testing.T) {
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	// newContext creates a request context which sends a given cookie.
+	newContext := func(cookie *http.Cookie) (echo.Context, *httptest.ResponseRecorder) {
+		ctx, rec := tests.NewContext(c.Web, "/")
+		if cookie != nil {
+			ctx.Request().AddCookie(cookie)
+		}
+		session.Store(ctx, c.Session)
+		return ctx, rec
+	}
+
+	get := func(ctx echo.Context) *sessions.Session {
+		sess, err := session.Get(ctx, authSessionName)
+		require.NoError(t, err)
+		return sess
+	}
+
+	// A new session is only stored once saved
+	sctx, rec := newContext(nil)
+	sess := get(sctx)
+	assert.True(t, sess.IsNew)
+	sess.Values[authSessionKeyUserID] = u.ID
+	sess.Values[authSessionKeyAuthenticated] = true
+	require.NoError(t, sess.Save(sctx.Request(), rec))
+	require.Len(t, rec.Result().Cookies(), 1)
+	cookie := rec.Result().Cookies()[0]
+
+	// The cookie only contains the session ID
+	assert.NotContains(t, cookie.Value, "authenticated")
+
+	// The session is loaded from the database
+	sctx, _ = newContext(cookie)
+	sess = get(sctx)
+	assert.False(t, sess.IsNew)
+	assert.Equal(t, u.ID, sess.Values[authSessionKeyUserID])
+
+	// The session belongs to the user it authenticates
+	ctx, _ := tests.NewContext(c.Web, "/")
+	list, err := c.Auth.GetSessions(ctx, u.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, hashSessionID(sess.ID), list[0].Token)
+	assert.NotEqual(t, sess.ID, list[0].Token)
+
+	// Revoking the session ends it
+	require.NoError(t, c.Auth.RevokeSession(ctx, u.ID, list[0].ID))
+	sctx, _ = newContext(cookie)
+	sess = get(sctx)
+	assert.True(t, sess.IsNew)
+	assert.Empty(t, sess.Values)
+
+	// Sessions revoked while a request is being handled are not brought back when the request saves them
+	sctx, rec = newContext(nil)
+	sess = get(sctx)
+	sess.Values[authSessionKeyUserID] = u.ID
+	sess.Values[authSessionKeyAuthenticated] = true
+	require.NoError(t, sess.Save(sctx.Request(), rec))
+	cookie = rec.Result().Cookies()[0]
+
+	sctx, rec = newContext(cookie)
+	sess = get(sctx)
+	require.False(t, sess.IsNew)
+	require.NoError(t, c.Auth.RevokeSessions(ctx, u.ID))
+	sess.Values["flash"] = "message"
+	require.NoError(t, sess.Save(sctx.Request(), rec))
+	assert.Empty(t, sess.Values)
+	require.Len(t, rec.Result().Cookies(), 1)
+	assert.Negative(t, rec.Result().Cookies()[0].MaxAge)
+
+	list, err = c.Auth.GetSessions(ctx, u.ID)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	// Sessions of other users cannot be revoked
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	sctx, rec = newContext(nil)
+	sess = get(sctx)
+	sess.Values[authSessionKeyUserID] = other.ID
+	sess.Values[authSessionKeyAuthenticated] = true
+	require.NoError(t, sess.Save(sctx.Request(), rec))
+	list, err = c.Auth.GetSessions(ctx, other.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Error(t, c.Auth.RevokeSession(ctx, u.ID, list[0].ID))
+
+	// Revoking all sessions of a user
+	require.NoError(t, c.Auth.RevokeSessions(ctx, other.ID))
+	list, err = c.Auth.GetSessions(ctx, other.ID)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	// Revoking the other sessions of a user keeps the one making the request
+	var cookies []*http.Cookie
+	for range 2 {
+		sctx, rec = newContext(nil)
+		sess = get(sctx)
+		sess.Values[authSessionKeyUserID] = other.ID
+		sess.Values[authSessionKeyAuthenticated] = true
+		require.NoError(t, sess.Save(sctx.Request(), rec))
+		cookies = append(cookies, rec.Result().Cookies()[0])
+	}
+	sctx, _ = newContext(cookies[0])
+	sess = get(sctx)
+	require.NoError(t, c.Auth.RevokeOtherSessions(sctx, other.ID))
+	list, err = c.Auth.GetSessions(ctx, other.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, hashSessionID(sess.ID), list[0].Token)
+
+	// Cookies which cannot be decoded result in a new session
+	sctx, _ = newContext(&http.Cookie{Name: authSessionName, Value: "invalid"})
+	assert.True(t, get(sctx).IsNew)
+}
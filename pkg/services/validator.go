@@ -1,6 +1,9 @@
 package services
 
 import (
+	"reflect"
+	"strings"
+
 	"github.com/go-playground/validator/v10"
 )
 
@@ -24,3 +27,34 @@ func (v *Validator) Validate(i any) error {
 	}
 	return nil
 }
+
+// RegisterPasswordPolicy registers validation tags which enforce a given password policy.
+// The "password" tag checks that a password meets the requirements of the policy. Its optional parameter is a
+// space-separated list of other fields in the struct containing personal information that the password cannot
+// contain, ie, `validate:"password=Email Name"`.
+// The "notbreached" tag checks that a password is not in the list of breached passwords.
+func (v *Validator) RegisterPasswordPolicy(p *PasswordPolicy) error {
+	err := v.validator.RegisterValidation("password", func(fl validator.FieldLevel) bool {
+		password := fl.Field().String()
+		if !p.Check(password) {
+			return false
+		}
+
+		var info []string
+		parent := reflect.Indirect(fl.Parent())
+		for _, name := range strings.Fields(fl.Param()) {
+			if field := parent.FieldByName(name); field.Kind() == reflect.String {
+				info = append(info, field.String())
+			}
+		}
+
+		return !p.ContainsPersonalInfo(password, info...)
+	})
+	if err != nil {
+		return err
+	}
+
+	return v.validator.RegisterValidation("notbreached", func(fl validator.FieldLevel) bool {
+		return !p.IsBreached(fl.Field().String())
+	})
+}
@@ -17,3 +17,19 @@ func TestValidator(t *testing.T) {
 	err = c.Validator.Validate(e)
 	assert.NoError(t, err)
 }
+
+func TestValidator_PasswordPolicy(t *testing.T) {
+	type example struct {
+		Email    string
+		Password string `validate:"password=Email,notbreached"`
+	}
+	e := example{
+		Email:    "johnsmith@localhost.localhost",
+		Password: "short",
+	}
+	assert.Error(t, c.Validator.Validate(e))
+	e.Password = "johnsmith-password"
+	assert.Error(t, c.Validator.Validate(e))
+	e.Password = "a-good-password"
+	assert.NoError(t, c.Validator.Validate(e))
+}
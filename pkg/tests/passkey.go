@@ -0,0 +1,200 @@
+package tests
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// Authenticator is a software authenticator which performs passkey registration and login ceremonies the way a
+// browser and platform authenticator would, so they can be tested without either.
+type Authenticator struct {
+	// Origin is the origin of the page the ceremonies are performed from.
+	Origin string
+
+	passkeys []*authenticatorPasskey
+}
+
+// authenticatorPasskey is a passkey stored by an Authenticator.
+type authenticatorPasskey struct {
+	id         []byte
+	rpID       string
+	userHandle []byte
+	key        *ecdsa.PrivateKey
+	signCount  uint32
+}
+
+// authenticatorOptions contains the options of both registration and login ceremonies which the Authenticator uses.
+type authenticatorOptions struct {
+	PublicKey struct {
+		Challenge string `json:"challenge"`
+		RPID      string `json:"rpId"`
+		RP        struct {
+			ID string `json:"id"`
+		} `json:"rp"`
+		User struct {
+			ID string `json:"id"`
+		} `json:"user"`
+	} `json:"publicKey"`
+}
+
+// NewAuthenticator creates a new software authenticator for ceremonies performed from a given origin.
+func NewAuthenticator(origin string) *Authenticator {
+	return &Authenticator{Origin: origin}
+}
+
+// Create registers a new passkey using given JSON-encoded registration options, and returns the JSON-encoded
+// response to send back.
+func (a *Authenticator) Create(options []byte) (string, error) {
+	var opts authenticatorOptions
+	if err := json.Unmarshal(options, &opts); err != nil {
+		return "", err
+	}
+
+	userHandle, err := base64.RawURLEncoding.DecodeString(opts.PublicKey.User.ID)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", err
+	}
+
+	p := &authenticatorPasskey{
+		id:         make([]byte, 16),
+		rpID:       opts.PublicKey.RP.ID,
+		userHandle: userHandle,
+		key:        key,
+	}
+	if _, err = rand.Read(p.id); err != nil {
+		return "", err
+	}
+
+	// The public key is encoded as a COSE key, which is an ES256 key on the P-256 curve.
+	ecdh, err := key.PublicKey.ECDH()
+	if err != nil {
+		return "", err
+	}
+	point := ecdh.Bytes()
+	publicKey, err := cbor.Marshal(map[int]any{
+		1:  2,
+		3:  -7,
+		-1: 1,
+		-2: point[1:33],
+		-3: point[33:],
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// The authenticator data contains the attested passkey, which is the AAGUID, which is left blank, followed by the
+	// length of the ID, the ID and the public key.
+	authData := p.authData(0x45)
+	authData = append(authData, make([]byte, 16)...)
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(p.id)))
+	authData = append(authData, p.id...)
+	authData = append(authData, publicKey...)
+
+	attestation, err := cbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	clientData, err := a.clientData("webauthn.create", opts.PublicKey.Challenge)
+	if err != nil {
+		return "", err
+	}
+
+	a.passkeys = append(a.passkeys, p)
+
+	return p.response(map[string]any{
+		"clientDataJSON":    b64(clientData),
+		"attestationObject": b64(attestation),
+		"transports":        []string{"internal"},
+	})
+}
+
+// Get logs in with the most recently registered passkey for the relying party using given JSON-encoded login
+// options, and returns the JSON-encoded response to send back.
+func (a *Authenticator) Get(options []byte) (string, error) {
+	var opts authenticatorOptions
+	if err := json.Unmarshal(options, &opts); err != nil {
+		return "", err
+	}
+
+	var p *authenticatorPasskey
+	for _, v := range a.passkeys {
+		if v.rpID == opts.PublicKey.RPID {
+			p = v
+		}
+	}
+	if p == nil {
+		return "", errors.New("no passkey for relying party")
+	}
+
+	clientData, err := a.clientData("webauthn.get", opts.PublicKey.Challenge)
+	if err != nil {
+		return "", err
+	}
+
+	p.signCount++
+	authData := p.authData(0x05)
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(authData, clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, p.key, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return p.response(map[string]any{
+		"clientDataJSON":    b64(clientData),
+		"authenticatorData": b64(authData),
+		"signature":         b64(sig),
+		"userHandle":        b64(p.userHandle),
+	})
+}
+
+// clientData returns the client data of a ceremony of a given type with a given challenge.
+func (a *Authenticator) clientData(typ, challenge string) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"type":      typ,
+		"challenge": challenge,
+		"origin":    a.Origin,
+	})
+}
+
+// authData returns the authenticator data of the passkey, without an attested passkey, with given flags.
+func (p *authenticatorPasskey) authData(flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(p.rpID))
+	b := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(b, p.signCount)
+}
+
+// response returns the JSON-encoded credential with a given response of the authenticator.
+func (p *authenticatorPasskey) response(response map[string]any) (string, error) {
+	b, err := json.Marshal(map[string]any{
+		"id":       b64(p.id),
+		"rawId":    b64(p.id),
+		"type":     "public-key",
+		"response": response,
+	})
+	return string(b), err
+}
+
+// b64 encodes given bytes the way WebAuthn does.
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -17,6 +17,7 @@ type (
 		Value       string
 		Placeholder string
 		Help        string
+		ReadOnly    bool
 	}
 
 	FileFieldParams struct {
@@ -156,6 +157,7 @@ func InputField(el InputFieldParams) Node {
 			Class("input "+formFieldStatusClass(el.Form, el.FormField)),
 			Value(el.Value),
 			If(el.Placeholder != "", Placeholder(el.Placeholder)),
+			If(el.ReadOnly, ReadOnly()),
 		),
 		Help(el.Help),
 		formFieldErrors(el.Form, el.FormField),
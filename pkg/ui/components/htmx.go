@@ -13,7 +13,9 @@ func HtmxListeners(r *ui.Request) Node {
 		document.body.addEventListener('htmx:beforeSwap', function(evt) {
 			if (evt.detail.xhr.status >= 400){
 				evt.detail.shouldSwap = true;
-				evt.detail.target = htmx.find("body");
+				if (!evt.detail.xhr.getResponseHeader("HX-Retarget")) {
+					evt.detail.target = htmx.find("body");
+				}
 			}
 		});
 	`
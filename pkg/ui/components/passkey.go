@@ -0,0 +1,78 @@
+package components
+
+import (
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+// PasskeyScript provides the script which performs passkey ceremonies for forms which call passkeyCeremony when
+// submitted. The options for the authenticator are loaded from the URL in the data-options attribute of the form,
+// and the response of the authenticator is submitted with the form in the credential field.
+func PasskeyScript() Node {
+	const script = `
+		function passkeyCeremony(evt) {
+			const form = evt.target;
+			if (form.elements.credential.value) {
+				return;
+			}
+			evt.preventDefault();
+
+			if (!window.PublicKeyCredential) {
+				alert('Your browser does not support passkeys.');
+				return;
+			}
+
+			const decode = s => Uint8Array.from(atob(s.replace(/-/g, '+').replace(/_/g, '/')), c => c.charCodeAt(0));
+			const encode = b => btoa(String.fromCharCode(...new Uint8Array(b)))
+				.replace(/\+/g, '-').replace(/\//g, '_').replace(/=+$/, '');
+
+			(async () => {
+				const res = await fetch(form.dataset.options, {headers: {'Accept': 'application/json'}});
+				if (!res.ok) {
+					alert('Unable to use a passkey. Please try again.');
+					return;
+				}
+
+				const options = (await res.json()).publicKey;
+				options.challenge = decode(options.challenge);
+
+				let cred;
+				try {
+					if (options.user) {
+						options.user.id = decode(options.user.id);
+						(options.excludeCredentials || []).forEach(c => c.id = decode(c.id));
+						cred = await navigator.credentials.create({publicKey: options});
+					} else {
+						(options.allowCredentials || []).forEach(c => c.id = decode(c.id));
+						cred = await navigator.credentials.get({publicKey: options});
+					}
+				} catch (e) {
+					// The user cancelled or the authenticator refused.
+					return;
+				}
+
+				const response = {};
+				for (const k of ['clientDataJSON', 'attestationObject', 'authenticatorData', 'signature', 'userHandle']) {
+					if (cred.response[k]) {
+						response[k] = encode(cred.response[k]);
+					}
+				}
+				if (cred.response.getTransports) {
+					response.transports = cred.response.getTransports();
+				}
+
+				form.elements.credential.value = JSON.stringify({
+					id: cred.id,
+					rawId: encode(cred.rawId),
+					type: cred.type,
+					authenticatorAttachment: cred.authenticatorAttachment,
+					clientExtensionResults: cred.getClientExtensionResults(),
+					response: response,
+				});
+				form.requestSubmit();
+			})();
+		}
+	`
+
+	return Script(Raw(script))
+}
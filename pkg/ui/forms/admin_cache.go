@@ -0,0 +1,57 @@
+package forms
+
+import (
+	"net/http"
+
+	"github.com/mikestefanello/pagoda/pkg/form"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+type AdminCacheFlush struct {
+	Group string `form:"group"`
+	Key   string `form:"key" validate:"required_without_all=Group Tag"`
+	Tag   string `form:"tag" validate:"required_without_all=Group Key"`
+	form.Submission
+}
+
+func (f *AdminCacheFlush) Render(r *ui.Request) Node {
+	return Form(
+		ID("cache-flush"),
+		Method(http.MethodPost),
+		Action(r.Path(routenames.AdminCacheSubmit)),
+		InputField(InputFieldParams{
+			Form:      f,
+			FormField: "Group",
+			Name:      "group",
+			InputType: "text",
+			Label:     "Group",
+			Value:     f.Group,
+			Help:      "The group the key belongs to. If no key is provided, the entire group will be flushed.",
+		}),
+		InputField(InputFieldParams{
+			Form:      f,
+			FormField: "Key",
+			Name:      "key",
+			InputType: "text",
+			Label:     "Key",
+			Value:     f.Key,
+		}),
+		InputField(InputFieldParams{
+			Form:      f,
+			FormField: "Tag",
+			Name:      "tag",
+			InputType: "text",
+			Label:     "Tag",
+			Value:     f.Tag,
+			Help:      "All entries with this tag will be flushed.",
+		}),
+		ControlGroup(
+			FormButton(ColorError, "Flush"),
+		),
+		CSRF(r),
+	)
+}
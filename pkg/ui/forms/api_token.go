@@ -0,0 +1,66 @@
+package forms
+
+import (
+	"net/http"
+
+	"github.com/mikestefanello/pagoda/pkg/form"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+type APIToken struct {
+	Name       string `form:"name" validate:"required,max=100"`
+	Access     string `form:"access" validate:"required,oneof=read write"`
+	Expiration string `form:"expiration" validate:"required,oneof=7 30 90 365 never"`
+	form.Submission
+}
+
+func (f *APIToken) Render(r *ui.Request) Node {
+	return Form(
+		ID("api-token"),
+		Method(http.MethodPost),
+		HxBoost(),
+		Action(r.Path(routenames.APITokenCreate)),
+		InputField(InputFieldParams{
+			Form:      f,
+			FormField: "Name",
+			Name:      "name",
+			InputType: "text",
+			Label:     "Name",
+			Value:     f.Name,
+			Help:      "What the token is for, so you can tell your tokens apart.",
+		}),
+		Radios(OptionsParams{
+			Form:      f,
+			FormField: "Access",
+			Name:      "access",
+			Label:     "Access",
+			Value:     f.Access,
+			Options: []Choice{
+				{Value: "read", Label: "Read only"},
+				{Value: "write", Label: "Read and write"},
+			},
+		}),
+		SelectList(OptionsParams{
+			Form:      f,
+			FormField: "Expiration",
+			Name:      "expiration",
+			Label:     "Expiration",
+			Value:     f.Expiration,
+			Options: []Choice{
+				{Value: "7", Label: "7 days"},
+				{Value: "30", Label: "30 days"},
+				{Value: "90", Label: "90 days"},
+				{Value: "365", Label: "1 year"},
+				{Value: "never", Label: "Never"},
+			},
+		}),
+		ControlGroup(
+			FormButton(ColorPrimary, "Create token"),
+		),
+		CSRF(r),
+	)
+}
@@ -0,0 +1,39 @@
+package forms
+
+import (
+	"net/http"
+
+	"github.com/mikestefanello/pagoda/pkg/form"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+type Invitation struct {
+	Email string `form:"email" validate:"required,email"`
+	form.Submission
+}
+
+func (f *Invitation) Render(r *ui.Request) Node {
+	return Form(
+		ID("invitation"),
+		Method(http.MethodPost),
+		HxBoost(),
+		Action(r.Path(routenames.InvitationCreate)),
+		InputField(InputFieldParams{
+			Form:      f,
+			FormField: "Email",
+			Name:      "email",
+			InputType: "email",
+			Label:     "Email address",
+			Value:     f.Email,
+			Help:      "We'll email them a link to create their account.",
+		}),
+		ControlGroup(
+			FormButton(ColorPrimary, "Send invitation"),
+		),
+		CSRF(r),
+	)
+}
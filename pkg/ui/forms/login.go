@@ -14,6 +14,7 @@ import (
 type Login struct {
 	Email    string `form:"email" validate:"required,email"`
 	Password string `form:"password" validate:"required"`
+	Remember bool   `form:"remember"`
 	form.Submission
 }
 
@@ -41,8 +42,16 @@ func (f *Login) Render(r *ui.Request) Node {
 			Placeholder: "******",
 		}),
 		Div(
-			Class("text-right text-primary mt-2"),
+			Class("flex justify-between items-center mt-2"),
+			Checkbox(CheckboxParams{
+				Form:      f,
+				FormField: "Remember",
+				Name:      "remember",
+				Label:     "Remember me",
+				Checked:   f.Remember,
+			}),
 			A(
+				Class("text-primary"),
 				Href(r.Path(routenames.ForgotPassword)),
 				Text("Forgot password?"),
 			),
@@ -52,6 +61,14 @@ func (f *Login) Render(r *ui.Request) Node {
 			ButtonLink(ColorLink, r.Path(routenames.Home), "Cancel"),
 		),
 		CSRF(r),
+		Div(
+			Class("text-center mt-4"),
+			A(
+				Class("text-primary"),
+				Href(r.Path(routenames.MagicLink)),
+				Text("Email me a login link instead"),
+			),
+		),
 		Div(
 			Class("text-center text-base-content/50 mt-4"),
 			Text("Don't have an account? "),
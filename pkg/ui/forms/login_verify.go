@@ -0,0 +1,41 @@
+package forms
+
+import (
+	"net/http"
+
+	"github.com/mikestefanello/pagoda/pkg/form"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+type LoginVerify struct {
+	Code string `form:"code" validate:"required"`
+	form.Submission
+}
+
+func (f *LoginVerify) Render(r *ui.Request) Node {
+	return Form(
+		ID("login-verify"),
+		Method(http.MethodPost),
+		HxBoost(),
+		Action(r.Path(routenames.LoginVerifySubmit)),
+		FlashMessages(r),
+		InputField(InputFieldParams{
+			Form:        f,
+			FormField:   "Code",
+			Name:        "code",
+			InputType:   "text",
+			Label:       "Authentication code",
+			Placeholder: "123456",
+			Help:        "Enter the code from your authenticator app, or one of your recovery codes.",
+		}),
+		ControlGroup(
+			FormButton(ColorPrimary, "Verify"),
+			ButtonLink(ColorLink, r.Path(routenames.Login), "Cancel"),
+		),
+		CSRF(r),
+	)
+}
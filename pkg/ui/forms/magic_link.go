@@ -0,0 +1,39 @@
+package forms
+
+import (
+	"net/http"
+
+	"github.com/mikestefanello/pagoda/pkg/form"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+type MagicLink struct {
+	Email string `form:"email" validate:"required,email"`
+	form.Submission
+}
+
+func (f *MagicLink) Render(r *ui.Request) Node {
+	return Form(
+		ID("magic-link"),
+		Method(http.MethodPost),
+		HxBoost(),
+		Action(r.Path(routenames.MagicLinkSubmit)),
+		InputField(InputFieldParams{
+			Form:      f,
+			FormField: "Email",
+			Name:      "email",
+			InputType: "email",
+			Label:     "Email address",
+			Value:     f.Email,
+		}),
+		ControlGroup(
+			FormButton(ColorPrimary, "Email me a link"),
+			ButtonLink(ColorLink, r.Path(routenames.Login), "Cancel"),
+		),
+		CSRF(r),
+	)
+}
@@ -0,0 +1,70 @@
+package forms
+
+import (
+	"net/http"
+
+	"github.com/mikestefanello/pagoda/pkg/form"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+type Passkey struct {
+	Name       string `form:"name" validate:"required,max=100"`
+	Credential string `form:"credential" validate:"required"`
+	form.Submission
+}
+
+type PasskeyLogin struct {
+	Credential string `form:"credential" validate:"required"`
+	form.Submission
+}
+
+func (f *Passkey) Render(r *ui.Request) Node {
+	return Form(
+		ID("passkey"),
+		Method(http.MethodPost),
+		Action(r.Path(routenames.PasskeyCreate)),
+		Data("options", r.Path(routenames.PasskeyRegister)),
+		Attr("onsubmit", "passkeyCeremony(event)"),
+		InputField(InputFieldParams{
+			Form:      f,
+			FormField: "Name",
+			Name:      "name",
+			InputType: "text",
+			Label:     "Name",
+			Value:     f.Name,
+			Help:      "Where the passkey is stored, such as your phone or password manager, so you can tell your passkeys apart.",
+		}),
+		Input(Type("hidden"), Name("credential")),
+		ControlGroup(
+			FormButton(ColorPrimary, "Add passkey"),
+		),
+		CSRF(r),
+		PasskeyScript(),
+	)
+}
+
+func (f *PasskeyLogin) Render(r *ui.Request) Node {
+	return Div(
+		Class("mt-4"),
+		Div(Class("divider text-base-content/50"), Text("or")),
+		Form(
+			ID("passkey-login"),
+			Method(http.MethodPost),
+			Action(r.Path(routenames.PasskeyLoginSubmit)),
+			Data("options", r.Path(routenames.PasskeyLogin)),
+			Attr("onsubmit", "passkeyCeremony(event)"),
+			Input(Type("hidden"), Name("credential")),
+			Button(
+				Class("btn btn-outline w-full mb-2"),
+				Type("submit"),
+				Text("Log in with a passkey"),
+			),
+			CSRF(r),
+		),
+		PasskeyScript(),
+	)
+}
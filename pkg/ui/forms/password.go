@@ -0,0 +1,41 @@
+package forms
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikestefanello/pagoda/pkg/ui"
+)
+
+// passwordHelp describes the requirements of the password policy in configuration.
+func passwordHelp(r *ui.Request) string {
+	if r.Config == nil {
+		return ""
+	}
+
+	policy := r.Config.App.PasswordPolicy
+	var classes []string
+	if policy.RequireUpper {
+		classes = append(classes, "an uppercase letter")
+	}
+	if policy.RequireLower {
+		classes = append(classes, "a lowercase letter")
+	}
+	if policy.RequireDigit {
+		classes = append(classes, "a number")
+	}
+	if policy.RequireSymbol {
+		classes = append(classes, "a symbol")
+	}
+
+	help := fmt.Sprintf("Must be at least %d characters long", policy.MinLength)
+	switch len(classes) {
+	case 0:
+	case 1:
+		help += " and contain " + classes[0]
+	default:
+		help += " and contain " + strings.Join(classes[:len(classes)-1], ", ") + " and " + classes[len(classes)-1]
+	}
+
+	return help + ". Cannot contain your name or email address."
+}
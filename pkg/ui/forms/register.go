@@ -14,8 +14,9 @@ import (
 type Register struct {
 	Name            string `form:"name" validate:"required"`
 	Email           string `form:"email" validate:"required,email"`
-	Password        string `form:"password" validate:"required"`
+	Password        string `form:"password" validate:"required,password=Email Name,notbreached"`
 	ConfirmPassword string `form:"password-confirm" validate:"required,eqfield=Password"`
+	Invitation      string `form:"invite"`
 	form.Submission
 }
 
@@ -25,6 +26,11 @@ func (f *Register) Render(r *ui.Request) Node {
 		Method(http.MethodPost),
 		HxBoost(),
 		Action(r.Path(routenames.RegisterSubmit)),
+		Input(
+			Type("hidden"),
+			Name("invite"),
+			Value(f.Invitation),
+		),
 		InputField(InputFieldParams{
 			Form:      f,
 			FormField: "Name",
@@ -40,6 +46,8 @@ func (f *Register) Render(r *ui.Request) Node {
 			InputType: "email",
 			Label:     "Email address",
 			Value:     f.Email,
+			// Invitations can only be accepted by the email address they were sent to.
+			ReadOnly: f.Invitation != "",
 		}),
 		InputField(InputFieldParams{
 			Form:        f,
@@ -48,6 +56,7 @@ func (f *Register) Render(r *ui.Request) Node {
 			InputType:   "password",
 			Label:       "Password",
 			Placeholder: "******",
+			Help:        passwordHelp(r),
 		}),
 		InputField(InputFieldParams{
 			Form:        f,
@@ -11,9 +11,13 @@ import (
 )
 
 type ResetPassword struct {
-	Password        string `form:"password" validate:"required"`
+	Password        string `form:"password" validate:"required,password=Email Name,notbreached"`
 	ConfirmPassword string `form:"password-confirm" validate:"required,eqfield=Password"`
 	form.Submission
+
+	// Email and Name are set from the user, rather than the submission, so the password can be checked against them.
+	Email string
+	Name  string
 }
 
 func (f *ResetPassword) Render(r *ui.Request) Node {
@@ -29,6 +33,7 @@ func (f *ResetPassword) Render(r *ui.Request) Node {
 			InputType:   "password",
 			Label:       "Password",
 			Placeholder: "******",
+			Help:        passwordHelp(r),
 		}),
 		InputField(InputFieldParams{
 			Form:        f,
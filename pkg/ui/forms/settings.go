@@ -0,0 +1,172 @@
+package forms
+
+import (
+	"net/http"
+
+	"github.com/mikestefanello/pagoda/pkg/form"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+type ChangeName struct {
+	Name string `form:"name" validate:"required"`
+	form.Submission
+}
+
+type ChangeEmail struct {
+	Email    string `form:"email" validate:"required,email"`
+	Password string `form:"password" validate:"required"`
+	form.Submission
+}
+
+type ChangePassword struct {
+	CurrentPassword string `form:"current-password" validate:"required"`
+	Password        string `form:"password" validate:"required,password=Email Name,notbreached"`
+	ConfirmPassword string `form:"password-confirm" validate:"required,eqfield=Password"`
+	form.Submission
+
+	// Email and Name are set from the user, rather than the submission, so the password can be checked against them.
+	Email string
+	Name  string
+}
+
+type ResendVerification struct{}
+
+type DeleteAccount struct {
+	Password string `form:"password" validate:"required"`
+	Confirm  bool   `form:"confirm" validate:"required"`
+	form.Submission
+}
+
+func (f *ChangeName) Render(r *ui.Request) Node {
+	return Form(
+		ID("change-name"),
+		Method(http.MethodPost),
+		HxBoost(),
+		Action(r.Path(routenames.SettingsName)),
+		InputField(InputFieldParams{
+			Form:      f,
+			FormField: "Name",
+			Name:      "name",
+			InputType: "text",
+			Label:     "Name",
+			Value:     f.Name,
+		}),
+		ControlGroup(
+			FormButton(ColorPrimary, "Change name"),
+		),
+		CSRF(r),
+	)
+}
+
+func (f *ChangeEmail) Render(r *ui.Request) Node {
+	return Form(
+		ID("change-email"),
+		Method(http.MethodPost),
+		HxBoost(),
+		Action(r.Path(routenames.SettingsEmail)),
+		InputField(InputFieldParams{
+			Form:      f,
+			FormField: "Email",
+			Name:      "email",
+			InputType: "email",
+			Label:     "New email address",
+			Value:     f.Email,
+			Help:      "A link to confirm the change will be sent to this address. Your email address will not change until it is confirmed.",
+		}),
+		InputField(InputFieldParams{
+			Form:        f,
+			FormField:   "Password",
+			Name:        "password",
+			InputType:   "password",
+			Label:       "Current password",
+			Placeholder: "******",
+		}),
+		ControlGroup(
+			FormButton(ColorPrimary, "Change email"),
+		),
+		CSRF(r),
+	)
+}
+
+func (f *ChangePassword) Render(r *ui.Request) Node {
+	return Form(
+		ID("change-password"),
+		Method(http.MethodPost),
+		HxBoost(),
+		Action(r.Path(routenames.SettingsPassword)),
+		InputField(InputFieldParams{
+			Form:        f,
+			FormField:   "CurrentPassword",
+			Name:        "current-password",
+			InputType:   "password",
+			Label:       "Current password",
+			Placeholder: "******",
+		}),
+		InputField(InputFieldParams{
+			Form:        f,
+			FormField:   "Password",
+			Name:        "password",
+			InputType:   "password",
+			Label:       "New password",
+			Placeholder: "******",
+			Help:        passwordHelp(r),
+		}),
+		InputField(InputFieldParams{
+			Form:        f,
+			FormField:   "ConfirmPassword",
+			Name:        "password-confirm",
+			InputType:   "password",
+			Label:       "Confirm new password",
+			Placeholder: "******",
+		}),
+		ControlGroup(
+			FormButton(ColorPrimary, "Change password"),
+		),
+		CSRF(r),
+	)
+}
+
+func (f *ResendVerification) Render(r *ui.Request) Node {
+	return Form(
+		ID("resend-verification"),
+		Method(http.MethodPost),
+		HxBoost(),
+		Action(r.Path(routenames.VerifyEmailResend)),
+		ControlGroup(
+			FormButton(ColorInfo, "Resend verification email"),
+		),
+		CSRF(r),
+	)
+}
+
+func (f *DeleteAccount) Render(r *ui.Request) Node {
+	return Form(
+		ID("delete-account"),
+		Method(http.MethodPost),
+		HxBoost(),
+		Action(r.Path(routenames.SettingsDelete)),
+		InputField(InputFieldParams{
+			Form:        f,
+			FormField:   "Password",
+			Name:        "password",
+			InputType:   "password",
+			Label:       "Current password",
+			Placeholder: "******",
+		}),
+		Checkbox(CheckboxParams{
+			Form:      f,
+			FormField: "Confirm",
+			Name:      "confirm",
+			Label:     "I understand that my account and all of its data will be permanently deleted.",
+			Checked:   f.Confirm,
+		}),
+		ControlGroup(
+			FormButton(ColorError, "Delete account"),
+		),
+		CSRF(r),
+	)
+}
@@ -0,0 +1,73 @@
+package forms
+
+import (
+	"net/http"
+
+	"github.com/mikestefanello/pagoda/pkg/form"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+type TwoFactorEnable struct {
+	// Key is the encrypted TOTP key being enrolled.
+	Key  string `form:"key" validate:"required"`
+	Code string `form:"code" validate:"required"`
+	form.Submission
+}
+
+type TwoFactorDisable struct {
+	Code string `form:"code" validate:"required"`
+	form.Submission
+}
+
+func (f *TwoFactorEnable) Render(r *ui.Request) Node {
+	return Form(
+		ID("two-factor-enable"),
+		Method(http.MethodPost),
+		HxBoost(),
+		Action(r.Path(routenames.TwoFactorEnable)),
+		Input(
+			Type("hidden"),
+			Name("key"),
+			Value(f.Key),
+		),
+		InputField(InputFieldParams{
+			Form:        f,
+			FormField:   "Code",
+			Name:        "code",
+			InputType:   "text",
+			Label:       "Authentication code",
+			Placeholder: "123456",
+			Help:        "Enter the code shown in your authenticator app to confirm it is set up.",
+		}),
+		ControlGroup(
+			FormButton(ColorPrimary, "Enable"),
+		),
+		CSRF(r),
+	)
+}
+
+func (f *TwoFactorDisable) Render(r *ui.Request) Node {
+	return Form(
+		ID("two-factor-disable"),
+		Method(http.MethodPost),
+		HxBoost(),
+		Action(r.Path(routenames.TwoFactorDisable)),
+		InputField(InputFieldParams{
+			Form:        f,
+			FormField:   "Code",
+			Name:        "code",
+			InputType:   "text",
+			Label:       "Authentication code",
+			Placeholder: "123456",
+			Help:        "Enter the code from your authenticator app, or one of your recovery codes.",
+		}),
+		ControlGroup(
+			FormButton(ColorError, "Disable"),
+		),
+		CSRF(r),
+	)
+}
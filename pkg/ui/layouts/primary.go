@@ -1,7 +1,10 @@
 package layouts
 
 import (
+	"net/http"
+
 	"github.com/mikestefanello/pagoda/ent/admin"
+	"github.com/mikestefanello/pagoda/pkg/permissions"
 	"github.com/mikestefanello/pagoda/pkg/routenames"
 	"github.com/mikestefanello/pagoda/pkg/ui"
 	"github.com/mikestefanello/pagoda/pkg/ui/cache"
@@ -31,6 +34,9 @@ func Primary(r *ui.Request, content Node) Node {
 					),
 					Div(
 						Class("drawer-content flex flex-col p-7 prose-base"),
+						Iff(r.Impersonator != nil, func() Node {
+							return impersonationBanner(r)
+						}),
 						If(len(r.Title) > 0, H1(Text(r.Title))),
 						FlashMessages(r),
 						content,
@@ -49,6 +55,25 @@ func Primary(r *ui.Request, content Node) Node {
 	)
 }
 
+func impersonationBanner(r *ui.Request) Node {
+	return Div(
+		Role("alert"),
+		Class("alert alert-warning mb-2"),
+		Span(
+			Textf("You are impersonating %s (%s).", r.AuthUser.Name, r.AuthUser.Email),
+		),
+		Form(
+			Method(http.MethodPost),
+			Action(r.Path(routenames.AdminImpersonateStop)),
+			Button(
+				Class("btn btn-sm"),
+				Text("Stop impersonating"),
+			),
+			CSRF(r),
+		),
+	)
+}
+
 func search() Node {
 	return cache.SetIfNotExists("layout.search", func() Node {
 		return Div(
@@ -121,24 +146,30 @@ func sidebarMenu(r *ui.Request) Node {
 	}
 
 	adminSubMenu := func() Node {
+		// Only the entity types the user can list are included.
 		entityTypeNames := admin.GetEntityTypeNames()
-		entityTypeLinks := make(Group, len(entityTypeNames))
+		entityTypeLinks := make(Group, 0, len(entityTypeNames))
 		for _, n := range entityTypeNames {
-			entityTypeLinks = append(entityTypeLinks, MenuLink(r, icons.PencilSquare(), n, routenames.AdminEntityList(n)))
+			if r.Can(permissions.Entity(n, permissions.ActionList)) {
+				entityTypeLinks = append(entityTypeLinks, MenuLink(r, icons.PencilSquare(), n, routenames.AdminEntityList(n)))
+			}
 		}
 
 		return Group{
-			header("Entities"),
+			If(len(entityTypeLinks) > 0, header("Entities")),
 			entityTypeLinks,
-			header("Monitoring"),
-			Li(
-				A(
-					icons.CircleStack(),
-					Href(r.Path(routenames.AdminTasks)),
-					Text("Tasks"),
-					Target("_blank"),
+			If(r.IsAdmin, Group{
+				header("Monitoring"),
+				MenuLink(r, icons.Archive(), "Cache", routenames.AdminCache),
+				Li(
+					A(
+						icons.CircleStack(),
+						Href(r.Path(routenames.AdminTasks)),
+						Text("Tasks"),
+						Target("_blank"),
+					),
 				),
-			),
+			}),
 		}
 	}
 
@@ -168,11 +199,17 @@ func sidebarMenu(r *ui.Request) Node {
 				MenuLink(r, icons.CircleStack(), "Task", routenames.Task),
 				MenuLink(r, icons.Document(), "Files", routenames.Files),
 				header("Account"),
+				If(r.IsAuth, MenuLink(r, icons.UserCircle(), "Settings", routenames.Settings)),
+				If(r.IsAuth, MenuLink(r, icons.LockClosed(), "Two-factor", routenames.TwoFactor)),
+				If(r.IsAuth, MenuLink(r, icons.FingerPrint(), "Passkeys", routenames.Passkeys)),
+				If(r.IsAuth, MenuLink(r, icons.Globe(), "Sessions", routenames.Sessions)),
+				If(r.IsAuth, MenuLink(r, icons.Key(), "API tokens", routenames.APITokens)),
+				If(r.Can(permissions.InviteUsers), MenuLink(r, icons.UserPlus(), "Invitations", routenames.Invitations)),
 				If(r.IsAuth, MenuLink(r, icons.Exit(), "Logout", routenames.Logout)),
 				If(!r.IsAuth, MenuLink(r, icons.Enter(), "Login", routenames.Login)),
 				If(!r.IsAuth, MenuLink(r, icons.UserPlus(), "Register", routenames.Register)),
 				If(!r.IsAuth, MenuLink(r, icons.QuestionCircle(), "Forgot password", routenames.ForgotPasswordSubmit)),
-				Iff(r.IsAdmin, adminSubMenu),
+				Iff(r.IsAuth, adminSubMenu),
 			),
 		),
 	)
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+type (
+	APITokens []*APIToken
+
+	APIToken struct {
+		ID         int
+		Name       string
+		Scopes     []string
+		CreatedAt  time.Time
+		LastUsedAt time.Time
+		ExpiresAt  time.Time
+	}
+)
+
+func (t APITokens) Render(r *ui.Request) Node {
+	if len(t) == 0 {
+		return P(Class("mb-2"), Text("You have not created any tokens yet."))
+	}
+
+	rows := make(Group, len(t))
+	for i, token := range t {
+		rows[i] = token.Render(r)
+	}
+
+	return Table(
+		Class("table table-zebra mb-2"),
+		THead(
+			Tr(
+				Th(Text("Name")),
+				Th(Text("Access")),
+				Th(Text("Created")),
+				Th(Text("Last used")),
+				Th(Text("Expires")),
+				Th(),
+			),
+		),
+		TBody(rows),
+	)
+}
+
+func (t *APIToken) Render(r *ui.Request) Node {
+	// formatTime formats times which are not required, such as the expiration.
+	formatTime := func(v time.Time, zero string) string {
+		if v.IsZero() {
+			return zero
+		}
+		return v.Format(time.DateTime)
+	}
+
+	return Tr(
+		Td(
+			Text(t.Name),
+			If(!t.ExpiresAt.IsZero() && time.Now().After(t.ExpiresAt),
+				Span(Class("ml-2"), Badge(ColorWarning, "Expired")),
+			),
+		),
+		Td(Text(strings.Join(t.Scopes, ", "))),
+		Td(Text(t.CreatedAt.Format(time.DateTime))),
+		Td(Text(formatTime(t.LastUsedAt, "Never"))),
+		Td(Text(formatTime(t.ExpiresAt, "Never"))),
+		Td(
+			Form(
+				Method(http.MethodPost),
+				Action(r.Path(routenames.APITokenRevoke, t.ID)),
+				Button(
+					Class("btn btn-error btn-sm"),
+					Text("Revoke"),
+				),
+				CSRF(r),
+			),
+		),
+	)
+}
@@ -0,0 +1,113 @@
+package models
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+type (
+	CacheStats struct {
+		Groups     []*CacheGroup
+		Tags       []*CacheTag
+		TagFlushes uint64
+		TagIndex   *CacheTagIndex
+	}
+
+	CacheTagIndex struct {
+		Tags      int
+		Keys      int
+		Capacity  int
+		Evictions uint64
+	}
+
+	CacheGroup struct {
+		Name      string
+		Hits      uint64
+		Misses    uint64
+		HitRatio  float64
+		Sets      uint64
+		Flushes   uint64
+		Evictions []CacheEviction
+	}
+
+	CacheEviction struct {
+		Cause string
+		Count uint64
+	}
+
+	CacheTag struct {
+		Name string
+		Keys int
+	}
+)
+
+func (g *CacheGroup) Render(r *ui.Request) Node {
+	name := g.Name
+	if name == "" {
+		name = "(none)"
+	}
+
+	// Keys without a group cannot be flushed together.
+	var flush Node
+	if g.Name != "" {
+		flush = Form(
+			Method(http.MethodPost),
+			Action(r.Path(routenames.AdminCacheSubmit)),
+			Input(
+				Type("hidden"),
+				Name("group"),
+				Value(g.Name),
+			),
+			Button(
+				Class("btn btn-error btn-sm"),
+				Text("Flush"),
+			),
+			CSRF(r),
+		)
+	}
+
+	evictions := make(Group, len(g.Evictions))
+	for i, e := range g.Evictions {
+		evictions[i] = Div(Textf("%s: %d", e.Cause, e.Count))
+	}
+
+	return Tr(
+		Td(Text(name)),
+		Td(Text(fmt.Sprint(g.Hits))),
+		Td(Text(fmt.Sprint(g.Misses))),
+		Td(Textf("%.1f%%", g.HitRatio*100)),
+		Td(Text(fmt.Sprint(g.Sets))),
+		Td(Text(fmt.Sprint(g.Flushes))),
+		Td(evictions),
+		Td(flush),
+	)
+}
+
+func (t *CacheTag) Render(r *ui.Request) Node {
+	return Tr(
+		Td(Text(t.Name)),
+		Td(Text(fmt.Sprint(t.Keys))),
+		Td(
+			Form(
+				Method(http.MethodPost),
+				Action(r.Path(routenames.AdminCacheSubmit)),
+				Input(
+					Type("hidden"),
+					Name("tag"),
+					Value(t.Name),
+				),
+				Button(
+					Class("btn btn-error btn-sm"),
+					Text("Flush"),
+				),
+				CSRF(r),
+			),
+		),
+	)
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+type (
+	Invitations []*Invitation
+
+	Invitation struct {
+		ID         int
+		Email      string
+		CreatedAt  time.Time
+		ExpiresAt  time.Time
+		AcceptedAt time.Time
+	}
+)
+
+func (i Invitations) Render(r *ui.Request) Node {
+	if len(i) == 0 {
+		return P(Class("mb-2"), Text("You have not sent any invitations yet."))
+	}
+
+	rows := make(Group, len(i))
+	for n, inv := range i {
+		rows[n] = inv.Render(r)
+	}
+
+	return Table(
+		Class("table table-zebra mb-2"),
+		THead(
+			Tr(
+				Th(Text("Email address")),
+				Th(Text("Sent")),
+				Th(Text("Status")),
+				Th(),
+			),
+		),
+		TBody(rows),
+	)
+}
+
+func (i *Invitation) Render(r *ui.Request) Node {
+	var status Node
+	switch {
+	case !i.AcceptedAt.IsZero():
+		status = Badge(ColorSuccess, "Accepted")
+	case time.Now().After(i.ExpiresAt):
+		status = Badge(ColorWarning, "Expired")
+	default:
+		status = Text("Expires " + i.ExpiresAt.Format(time.DateTime))
+	}
+
+	return Tr(
+		Td(Text(i.Email)),
+		Td(Text(i.CreatedAt.Format(time.DateTime))),
+		Td(status),
+		Td(
+			If(i.AcceptedAt.IsZero(), Form(
+				Method(http.MethodPost),
+				Action(r.Path(routenames.InvitationRevoke, i.ID)),
+				Button(
+					Class("btn btn-error btn-sm"),
+					Text("Revoke"),
+				),
+				CSRF(r),
+			)),
+		),
+	)
+}
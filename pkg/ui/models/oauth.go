@@ -0,0 +1,38 @@
+package models
+
+import (
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+type (
+	OAuthProviders []*OAuthProvider
+
+	OAuthProvider struct {
+		Name  string
+		Label string
+	}
+)
+
+func (p OAuthProviders) Render(r *ui.Request) Node {
+	if len(p) == 0 {
+		return nil
+	}
+
+	buttons := make(Group, len(p))
+	for i, provider := range p {
+		buttons[i] = provider.Render(r)
+	}
+
+	return Div(buttons)
+}
+
+func (p *OAuthProvider) Render(r *ui.Request) Node {
+	return A(
+		Class("btn btn-outline w-full mb-2"),
+		Href(r.Path(routenames.OAuthLogin, p.Name)),
+		Text("Log in with "+p.Label),
+	)
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+type (
+	Passkeys []*Passkey
+
+	Passkey struct {
+		ID         int
+		Name       string
+		CreatedAt  time.Time
+		LastUsedAt time.Time
+	}
+)
+
+func (p Passkeys) Render(r *ui.Request) Node {
+	if len(p) == 0 {
+		return P(Class("mb-2"), Text("You have not added any passkeys yet."))
+	}
+
+	rows := make(Group, len(p))
+	for i, passkey := range p {
+		rows[i] = passkey.Render(r)
+	}
+
+	return Table(
+		Class("table table-zebra mb-2"),
+		THead(
+			Tr(
+				Th(Text("Name")),
+				Th(Text("Added")),
+				Th(Text("Last used")),
+				Th(),
+			),
+		),
+		TBody(rows),
+	)
+}
+
+func (p *Passkey) Render(r *ui.Request) Node {
+	lastUsed := "Never"
+	if !p.LastUsedAt.IsZero() {
+		lastUsed = p.LastUsedAt.Format(time.DateTime)
+	}
+
+	return Tr(
+		Td(Text(p.Name)),
+		Td(Text(p.CreatedAt.Format(time.DateTime))),
+		Td(Text(lastUsed)),
+		Td(
+			Form(
+				Method(http.MethodPost),
+				Action(r.Path(routenames.PasskeyDelete, p.ID)),
+				Button(
+					Class("btn btn-error btn-sm"),
+					Text("Remove"),
+				),
+				CSRF(r),
+			),
+		),
+	)
+}
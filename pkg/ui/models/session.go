@@ -0,0 +1,76 @@
+package models
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+type (
+	Sessions []*Session
+
+	Session struct {
+		ID         int
+		IP         string
+		UserAgent  string
+		CreatedAt  time.Time
+		LastSeenAt time.Time
+
+		// Current indicates if this is the session making the request.
+		Current bool
+	}
+)
+
+func (s Sessions) Render(r *ui.Request) Node {
+	rows := make(Group, len(s))
+	for i, session := range s {
+		rows[i] = session.Render(r)
+	}
+
+	return Table(
+		Class("table table-zebra mb-2"),
+		THead(
+			Tr(
+				Th(Text("Device")),
+				Th(Text("IP address")),
+				Th(Text("Signed in")),
+				Th(Text("Last seen")),
+				Th(),
+			),
+		),
+		TBody(rows),
+	)
+}
+
+func (s *Session) Render(r *ui.Request) Node {
+	device := s.UserAgent
+	if device == "" {
+		device = "Unknown"
+	}
+
+	return Tr(
+		Td(
+			Text(device),
+			If(s.Current, Span(Class("ml-2"), Badge(ColorSuccess, "This device"))),
+		),
+		Td(Text(s.IP)),
+		Td(Text(s.CreatedAt.Format(time.DateTime))),
+		Td(Text(s.LastSeenAt.Format(time.DateTime))),
+		Td(
+			Form(
+				Method(http.MethodPost),
+				Action(r.Path(routenames.SessionRevoke, s.ID)),
+				Button(
+					Class("btn btn-error btn-sm"),
+					Text("Revoke"),
+				),
+				CSRF(r),
+			),
+		),
+	)
+}
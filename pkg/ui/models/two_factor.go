@@ -0,0 +1,52 @@
+package models
+
+import (
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+type (
+	TwoFactorSetup struct {
+		// QRCode is a data URI of the QR code image for the key.
+		QRCode string
+
+		// Secret is the secret of the key, for authenticator apps that cannot scan the QR code.
+		Secret string
+	}
+
+	RecoveryCodes []string
+)
+
+func (s *TwoFactorSetup) Render() Node {
+	return Div(
+		Class("flex flex-col items-center gap-2 mb-4"),
+		P(Text("Scan this QR code with your authenticator app.")),
+		Img(
+			Src(s.QRCode),
+			Alt("QR code"),
+			Width("200"),
+			Height("200"),
+		),
+		P(
+			Text("Or enter this key manually: "),
+			Code(Text(s.Secret)),
+		),
+	)
+}
+
+func (c RecoveryCodes) Render() Node {
+	items := make(Group, len(c))
+	for i, code := range c {
+		items[i] = Li(Code(Text(code)))
+	}
+
+	return Group{
+		Alert(ColorWarning, "Store these recovery codes somewhere safe. Each can be used once to log in if you lose "+
+			"access to your authenticator app, and they will not be shown again."),
+		Ul(
+			Class("grid grid-cols-2 gap-2 font-mono"),
+			items,
+		),
+	}
+}
@@ -0,0 +1,102 @@
+package pages
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	"github.com/mikestefanello/pagoda/pkg/ui/forms"
+	"github.com/mikestefanello/pagoda/pkg/ui/layouts"
+	"github.com/mikestefanello/pagoda/pkg/ui/models"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+func AdminCache(ctx echo.Context, stats *models.CacheStats, form *forms.AdminCacheFlush) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "Cache"
+
+	var hits, misses uint64
+	groupRows := make(Group, len(stats.Groups))
+	for i, g := range stats.Groups {
+		hits += g.Hits
+		misses += g.Misses
+		groupRows[i] = g.Render(r)
+	}
+
+	tagRows := make(Group, len(stats.Tags))
+	for i, t := range stats.Tags {
+		tagRows[i] = t.Render(r)
+	}
+
+	summary := []Stat{
+		{
+			Title:       "Hits",
+			Value:       fmt.Sprint(hits),
+			Description: fmt.Sprintf("%d misses", misses),
+		},
+		{
+			Title: "Tag flushes",
+			Value: fmt.Sprint(stats.TagFlushes),
+		},
+	}
+
+	if stats.TagIndex != nil {
+		summary = append(summary, Stat{
+			Title:       "Tagged keys",
+			Value:       fmt.Sprint(stats.TagIndex.Keys),
+			Description: fmt.Sprintf("%d tags, capacity of %d", stats.TagIndex.Tags, stats.TagIndex.Capacity),
+		}, Stat{
+			Title:       "Tag index evictions",
+			Value:       fmt.Sprint(stats.TagIndex.Evictions),
+			Description: "Entries evicted because the tag index was full",
+		})
+	}
+
+	n := Group{
+		Div(
+			Class("mb-4"),
+			Stats(summary...),
+		),
+		H3(Text("Groups")),
+		Card(CardParams{
+			Body:  Group{Text("Usage of each cache group since the application started.")},
+			Color: ColorInfo,
+			Size:  SizeMedium,
+		}),
+		Table(
+			Class("table table-zebra mb-2"),
+			THead(
+				Tr(
+					Th(Text("Group")),
+					Th(Text("Hits")),
+					Th(Text("Misses")),
+					Th(Text("Hit ratio")),
+					Th(Text("Sets")),
+					Th(Text("Flushes")),
+					Th(Text("Evictions")),
+					Th(),
+				),
+			),
+			TBody(groupRows),
+		),
+		H3(Text("Tags")),
+		If(len(stats.Tags) == 0, P(Text("No tags are in use."))),
+		If(len(stats.Tags) > 0, Table(
+			Class("table table-zebra mb-2"),
+			THead(
+				Tr(
+					Th(Text("Tag")),
+					Th(Text("Keys")),
+					Th(),
+				),
+			),
+			TBody(tagRows),
+		)),
+		H3(Text("Flush")),
+		form.Render(r),
+	}
+
+	return r.Render(layouts.Primary, n)
+}
@@ -2,11 +2,13 @@ package pages
 
 import (
 	"fmt"
+	"net/http"
 	"net/url"
 
 	"entgo.io/ent/entc/load"
 	"github.com/labstack/echo/v4"
 	"github.com/mikestefanello/pagoda/ent/admin"
+	"github.com/mikestefanello/pagoda/pkg/permissions"
 	"github.com/mikestefanello/pagoda/pkg/routenames"
 	"github.com/mikestefanello/pagoda/pkg/ui"
 	. "github.com/mikestefanello/pagoda/pkg/ui/components"
@@ -48,6 +50,11 @@ func AdminEntityList(
 	r := ui.NewRequest(ctx)
 	r.Title = entityTypeName
 
+	can := func(action string) bool {
+		return permissions.CanEntity(entityTypeName, action) &&
+			r.Can(permissions.Entity(entityTypeName, action))
+	}
+
 	genHeader := func() Node {
 		g := make(Group, 0, len(entityList.Columns)+2)
 		g = append(g, Th(Text("ID")))
@@ -66,17 +73,30 @@ func AdminEntityList(
 		}
 		g = append(g,
 			Td(
-				ButtonLink(
-					ColorInfo,
-					r.Path(routenames.AdminEntityEdit(entityTypeName), row.ID),
-					"Edit",
-				),
-				Span(Class("mr-2")),
-				ButtonLink(
+				If(can(permissions.ActionEdit), Group{
+					ButtonLink(
+						ColorInfo,
+						r.Path(routenames.AdminEntityEdit(entityTypeName), row.ID),
+						"Edit",
+					),
+					Span(Class("mr-2")),
+				}),
+				If(can(permissions.ActionDelete), ButtonLink(
 					ColorError,
 					r.Path(routenames.AdminEntityDelete(entityTypeName), row.ID),
 					"Delete",
-				),
+				)),
+				// Admins can impersonate other users, though whether a given user can be impersonated is checked on submit.
+				If(entityTypeName == "User" && r.IsAdmin && row.ID != r.AuthUser.ID, Form(
+					Class("inline ml-2"),
+					Method(http.MethodPost),
+					Action(r.Path(routenames.AdminImpersonate, row.ID)),
+					Button(
+						Class("btn btn-warning"),
+						Text("Impersonate"),
+					),
+					CSRF(r),
+				)),
 			),
 		)
 		return g
@@ -91,14 +111,14 @@ func AdminEntityList(
 	}
 
 	return r.Render(layouts.Primary, Group{
-		Div(
+		If(can(permissions.ActionAdd), Div(
 			Class("form-control mb-2"),
 			ButtonLink(
 				ColorAccent,
 				r.Path(routenames.AdminEntityAdd(entityTypeName)),
 				fmt.Sprintf("Add %s", entityTypeName),
 			),
-		),
+		)),
 		Table(
 			Class("table table-zebra mb-2"),
 			THead(
@@ -108,7 +128,7 @@ func AdminEntityList(
 		),
 		Pager(
 			entityList.Page,
-			r.Path(routenames.AdminEntityAdd(entityTypeName)),
+			r.Path(routenames.AdminEntityList(entityTypeName)),
 			entityList.HasNextPage,
 			"",
 		),
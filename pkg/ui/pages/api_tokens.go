@@ -0,0 +1,48 @@
+package pages
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	"github.com/mikestefanello/pagoda/pkg/ui/forms"
+	"github.com/mikestefanello/pagoda/pkg/ui/layouts"
+	"github.com/mikestefanello/pagoda/pkg/ui/models"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+func APITokens(ctx echo.Context, tokens models.APITokens, form *forms.APIToken) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "API tokens"
+
+	n := Group{
+		P(Text("API tokens let scripts and other applications access your account without your password. " +
+			"Send a token in the Authorization header of requests, as a bearer token. Revoke any token you no " +
+			"longer use.")),
+		tokens.Render(r),
+		Divider(""),
+		H3(Text("New token")),
+		form.Render(r),
+	}
+
+	return r.Render(layouts.Primary, n)
+}
+
+func APITokenCreated(ctx echo.Context, token string) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "API token created"
+
+	n := Group{
+		Alert(ColorWarning, "Copy this token now and store it somewhere safe. It will not be shown again."),
+		P(
+			Class("my-4"),
+			Code(Class("break-all"), Text(token)),
+		),
+		ControlGroup(
+			ButtonLink(ColorPrimary, r.Path(routenames.APITokens), "Done"),
+		),
+	}
+
+	return r.Render(layouts.Primary, n)
+}
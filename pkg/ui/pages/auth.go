@@ -2,17 +2,33 @@ package pages
 
 import (
 	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
 	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
 	"github.com/mikestefanello/pagoda/pkg/ui/forms"
 	"github.com/mikestefanello/pagoda/pkg/ui/layouts"
+	"github.com/mikestefanello/pagoda/pkg/ui/models"
 	. "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
 )
 
-func Login(ctx echo.Context, form *forms.Login) error {
+func Login(ctx echo.Context, form *forms.Login, passkey *forms.PasskeyLogin, providers models.OAuthProviders) error {
 	r := ui.NewRequest(ctx)
 	r.Title = "Login"
 
+	g := Group{
+		form.Render(r),
+		passkey.Render(r),
+		providers.Render(r),
+	}
+
+	return r.Render(layouts.Auth, g)
+}
+
+func LoginVerify(ctx echo.Context, form *forms.LoginVerify) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "Two-factor authentication"
+
 	return r.Render(layouts.Auth, form.Render(r))
 }
 
@@ -23,6 +39,24 @@ func Register(ctx echo.Context, form *forms.Register) error {
 	return r.Render(layouts.Auth, form.Render(r))
 }
 
+func RegisterInviteOnly(ctx echo.Context) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "Register"
+
+	g := Group{
+		Div(
+			Class("content"),
+			P(Text("Registration is by invitation only. If you have been invited, use the link in your invitation "+
+				"email to create your account.")),
+		),
+		ControlGroup(
+			ButtonLink(ColorPrimary, r.Path(routenames.Login), "Login"),
+		),
+	}
+
+	return r.Render(layouts.Auth, g)
+}
+
 func ForgotPassword(ctx echo.Context, form *forms.ForgotPassword) error {
 	r := ui.NewRequest(ctx)
 	r.Title = "Forgot password"
@@ -38,9 +72,40 @@ func ForgotPassword(ctx echo.Context, form *forms.ForgotPassword) error {
 	return r.Render(layouts.Auth, g)
 }
 
+func MagicLink(ctx echo.Context, form *forms.MagicLink) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "Log in with a link"
+
+	g := Group{
+		Div(
+			Class("content"),
+			P(Text("Enter your email address and we'll email you a link that logs you in without your password.")),
+		),
+		form.Render(r),
+	}
+
+	return r.Render(layouts.Auth, g)
+}
+
 func ResetPassword(ctx echo.Context, form *forms.ResetPassword) error {
 	r := ui.NewRequest(ctx)
 	r.Title = "Reset your password"
 
 	return r.Render(layouts.Auth, form.Render(r))
 }
+
+func VerifyEmailNotice(ctx echo.Context) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "Verify your email address"
+
+	g := Group{
+		Div(
+			Class("content"),
+			P(Textf("Please verify your email address to continue. We sent a link to verify it to %s when you "+
+				"registered. If you cannot find it, we can send you a new one.", r.AuthUser.Email)),
+		),
+		new(forms.ResendVerification).Render(r),
+	}
+
+	return r.Render(layouts.Primary, g)
+}
@@ -21,6 +21,8 @@ func Error(ctx echo.Context, code int) error {
 		body = Text("Please try again.")
 	case http.StatusForbidden, http.StatusUnauthorized:
 		body = Text("You are not authorized to view the requested page.")
+	case http.StatusTooManyRequests:
+		body = Text("You have made too many requests. Please wait a moment and try again.")
 	case http.StatusNotFound:
 		body = Group{
 			Text("Click "),
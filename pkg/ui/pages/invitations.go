@@ -0,0 +1,28 @@
+package pages
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	"github.com/mikestefanello/pagoda/pkg/ui/forms"
+	"github.com/mikestefanello/pagoda/pkg/ui/layouts"
+	"github.com/mikestefanello/pagoda/pkg/ui/models"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+func Invitations(ctx echo.Context, invitations models.Invitations, form *forms.Invitation) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "Invitations"
+
+	n := Group{
+		P(Text("Invite people to create an account. Each invitation can only be used once, by the email address it " +
+			"was sent to, and expires if it is not used.")),
+		invitations.Render(r),
+		Divider(""),
+		H3(Text("New invitation")),
+		form.Render(r),
+	}
+
+	return r.Render(layouts.Primary, n)
+}
@@ -0,0 +1,29 @@
+package pages
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	"github.com/mikestefanello/pagoda/pkg/ui/forms"
+	"github.com/mikestefanello/pagoda/pkg/ui/layouts"
+	"github.com/mikestefanello/pagoda/pkg/ui/models"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+func Passkeys(ctx echo.Context, passkeys models.Passkeys, form *forms.Passkey) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "Passkeys"
+
+	n := Group{
+		P(Text("Passkeys let you log in without your password, using your fingerprint, face or screen lock instead. " +
+			"They are stored on your devices or in your password manager, and cannot be guessed or phished. Remove " +
+			"any passkey you no longer use.")),
+		passkeys.Render(r),
+		Divider(""),
+		H3(Text("New passkey")),
+		form.Render(r),
+	}
+
+	return r.Render(layouts.Primary, n)
+}
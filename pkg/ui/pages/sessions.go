@@ -0,0 +1,23 @@
+package pages
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	"github.com/mikestefanello/pagoda/pkg/ui/layouts"
+	"github.com/mikestefanello/pagoda/pkg/ui/models"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+func Sessions(ctx echo.Context, sessions models.Sessions) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "Sessions"
+
+	n := Group{
+		P(Text("These are the devices that are currently logged in to your account. If you do not recognize one, " +
+			"revoke it, which logs it out immediately, and change your password.")),
+		sessions.Render(r),
+	}
+
+	return r.Render(layouts.Primary, n)
+}
@@ -0,0 +1,52 @@
+package pages
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	"github.com/mikestefanello/pagoda/pkg/ui/forms"
+	"github.com/mikestefanello/pagoda/pkg/ui/layouts"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+func Settings(
+	ctx echo.Context,
+	name *forms.ChangeName,
+	email *forms.ChangeEmail,
+	password *forms.ChangePassword,
+	deleteAccount *forms.DeleteAccount,
+) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "Settings"
+
+	verified := "It has been verified."
+	if !r.AuthUser.Verified {
+		verified = "It has not been verified yet. Check your inbox for the verification email, or have it sent again."
+	}
+
+	n := Group{
+		H3(Text("Profile")),
+		name.Render(r),
+		Divider(""),
+		H3(Text("Email address")),
+		P(
+			Textf("Your email address is %s. ", r.AuthUser.Email),
+			Text(verified),
+		),
+		Iff(!r.AuthUser.Verified, func() Node {
+			return new(forms.ResendVerification).Render(r)
+		}),
+		email.Render(r),
+		Divider(""),
+		H3(Text("Password")),
+		P(Text("Changing your password logs out all of your other sessions.")),
+		password.Render(r),
+		Divider(""),
+		H3(Text("Delete account")),
+		Alert(ColorError, "Deleting your account is permanent and cannot be undone."),
+		deleteAccount.Render(r),
+	}
+
+	return r.Render(layouts.Primary, n)
+}
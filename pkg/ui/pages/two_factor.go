@@ -0,0 +1,55 @@
+package pages
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/routenames"
+	"github.com/mikestefanello/pagoda/pkg/ui"
+	. "github.com/mikestefanello/pagoda/pkg/ui/components"
+	"github.com/mikestefanello/pagoda/pkg/ui/forms"
+	"github.com/mikestefanello/pagoda/pkg/ui/layouts"
+	"github.com/mikestefanello/pagoda/pkg/ui/models"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+func TwoFactor(
+	ctx echo.Context,
+	setup *models.TwoFactorSetup,
+	enable *forms.TwoFactorEnable,
+	disable *forms.TwoFactorDisable,
+) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "Two-factor authentication"
+
+	var n Node
+	if r.AuthUser.TotpSecret != "" {
+		n = Group{
+			Alert(ColorSuccess, "Two-factor authentication is enabled for your account."),
+			P(Text("To disable it, enter a code from your authenticator app or one of your recovery codes.")),
+			disable.Render(r),
+		}
+	} else {
+		n = Group{
+			P(Text("Protect your account by requiring a code from an authenticator app, in addition to your password, when you log in.")),
+			setup.Render(),
+			enable.Render(r),
+		}
+	}
+
+	return r.Render(layouts.Primary, n)
+}
+
+func RecoveryCodes(ctx echo.Context, codes models.RecoveryCodes) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "Recovery codes"
+
+	n := Group{
+		Alert(ColorSuccess, "Two-factor authentication has been enabled for your account."),
+		codes.Render(),
+		ControlGroup(
+			ButtonLink(ColorPrimary, r.Path(routenames.Home), "Done"),
+		),
+	}
+
+	return r.Render(layouts.Primary, n)
+}
@@ -34,6 +34,13 @@ type (
 		// AuthUser stores the authenticated user.
 		AuthUser *ent.User
 
+		// Impersonator stores the admin impersonating the authenticated user, if any.
+		Impersonator *ent.User
+
+		// Permissions stores the names of the permissions granted to the authenticated user by their roles.
+		// Use Can() to check a permission, since admins have every permission.
+		Permissions map[string]bool
+
 		// Metatags stores metatag values.
 		Metatags struct {
 			// Description stores the description metatag value.
@@ -83,6 +90,14 @@ func NewRequest(ctx echo.Context) *Request {
 		p.IsAdmin = p.AuthUser.Admin
 	}
 
+	if impersonator := ctx.Get(context.ImpersonatorKey); impersonator != nil {
+		p.Impersonator = impersonator.(*ent.User)
+	}
+
+	if permissions := ctx.Get(context.PermissionsKey); permissions != nil {
+		p.Permissions = permissions.(map[string]bool)
+	}
+
 	if cfg := ctx.Get(context.ConfigKey); cfg != nil {
 		p.Config = cfg.(*config.Config)
 	}
@@ -90,6 +105,22 @@ func NewRequest(ctx echo.Context) *Request {
 	return p
 }
 
+// Can determines if the authenticated user has a given permission, which can be used to only render the controls
+// they are allowed to use.
+func (r *Request) Can(permission string) bool {
+	return r.IsAdmin || r.Permissions[permission]
+}
+
+// CanAny determines if the authenticated user has at least one of the given permissions.
+func (r *Request) CanAny(permissions ...string) bool {
+	for _, permission := range permissions {
+		if r.Can(permission) {
+			return true
+		}
+	}
+	return false
+}
+
 // Path generates a URL path for a given route name and optional route parameters.
 // This will only work if you've supplied names for each of your routes. It's optional to use and helps avoids
 // having duplicate, hard-coded paths and parameters all over your application.
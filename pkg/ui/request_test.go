@@ -46,6 +46,36 @@ func TestNewRequest(t *testing.T) {
 	assert.Equal(t, usr, r.AuthUser)
 	assert.Equal(t, "12345", r.CSRF)
 	assert.Equal(t, "testing", r.Config.App.Name)
+	assert.Nil(t, r.Impersonator)
+
+	impersonator := &ent.User{
+		ID:    2,
+		Admin: true,
+	}
+	ctx.Set(context.ImpersonatorKey, impersonator)
+	r = NewRequest(ctx)
+	assert.Equal(t, impersonator, r.Impersonator)
+	assert.False(t, r.IsAdmin)
+}
+
+func TestRequest_Can(t *testing.T) {
+	e := echo.New()
+	ctx, _ := tests.NewContext(e, "/")
+	r := NewRequest(ctx)
+	assert.False(t, r.Can("entity.user.edit"))
+
+	ctx.Set(context.AuthenticatedUserKey, &ent.User{ID: 1})
+	ctx.Set(context.PermissionsKey, map[string]bool{"entity.user.edit": true})
+	r = NewRequest(ctx)
+	assert.True(t, r.Can("entity.user.edit"))
+	assert.False(t, r.Can("entity.user.delete"))
+	assert.True(t, r.CanAny("entity.user.delete", "entity.user.edit"))
+	assert.False(t, r.CanAny("entity.user.delete"))
+
+	// Admins have every permission
+	ctx.Set(context.AuthenticatedUserKey, &ent.User{ID: 1, Admin: true})
+	r = NewRequest(ctx)
+	assert.True(t, r.Can("entity.user.delete"))
 }
 
 func TestRequest_UrlPath(t *testing.T) {